@@ -0,0 +1,452 @@
+// Package certificates runs a certificate authority for each account, issuing X.509 client certificates identifying
+// devices by their application profile.
+//
+// The wasp and vespiary brokers do not accept client certificates yet: devices connecting to them must still
+// authenticate with their profile password. Issued certificates are meant for brokers configured to trust the
+// account authority and its revocation list.
+package certificates
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/rpc"
+	"github.com/vx-labs/alveoli/alveoli/store"
+)
+
+const (
+	// AuthorityStoreKind is the store namespace holding the certificate authority of each account.
+	AuthorityStoreKind = "device-authorities"
+	// CertificateStoreKind is the store namespace holding issued certificates, indexed by serial number.
+	CertificateStoreKind = "device-certificates"
+
+	authorityKey        = "ca"
+	authorityValidity   = 10 * 12 * 30 * 24 * time.Hour
+	certificateValidity = 12 * 30 * 24 * time.Hour
+	crlValidity         = 24 * time.Hour
+	// crlRefresh is how long a signed revocation list is served before being signed again, even when no
+	// certificate was revoked, so brokers never get a list close to its next update.
+	crlRefresh        = crlValidity / 2
+	keyDerivationInfo = "alveoli device certificate authority/"
+	minimumRSAKeySize = 2048
+)
+
+var (
+	// ErrNotFound is returned when the requested certificate does not exist.
+	ErrNotFound = errors.New("device certificate not found")
+	// ErrNoAuthority is returned when the account authority does not exist yet.
+	ErrNoAuthority = errors.New("no device certificate was issued for this account")
+	// ErrDisabled is returned when certificates must be signed but the server has no authority secret.
+	ErrDisabled = errors.New("device certificates are not enabled on this server")
+)
+
+// Error describes an invalid certificate signing request.
+type Error struct {
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid certificate signing request: %s", e.Reason)
+}
+
+// Identity is the application profile a certificate is issued for.
+type Identity struct {
+	AccountID            string
+	ApplicationID        string
+	ApplicationProfileID string
+	// Username is the MQTT username of the profile, used as the certificate common name.
+	Username string
+}
+
+// Certificate is an issued device certificate.
+type Certificate struct {
+	SerialNumber         string     `json:"serialNumber"`
+	ApplicationID        string     `json:"applicationId"`
+	ApplicationProfileID string     `json:"applicationProfileId"`
+	Subject              string     `json:"subject"`
+	NotBefore            time.Time  `json:"notBefore"`
+	NotAfter             time.Time  `json:"notAfter"`
+	RevokedAt            *time.Time `json:"revokedAt,omitempty"`
+	// Certificate is the PEM encoded certificate.
+	Certificate string `json:"certificate"`
+}
+
+// Issued is the result of a certificate issuance. PrivateKey is only set when the key was generated by alveoli, and
+// is not stored.
+type Issued struct {
+	Certificate Certificate
+	PrivateKey  string
+}
+
+type authority struct {
+	Certificate string `json:"certificate"`
+}
+
+// signedCRL is a revocation list signed for an account.
+type signedCRL struct {
+	// revoked lists the serial numbers of the revoked certificates included in the list.
+	revoked    string
+	thisUpdate time.Time
+	der        []byte
+}
+
+// Authority issues and revokes device certificates.
+//
+// The certificate authority of an account is created when its first certificate is issued. Only its certificate is
+// stored: its private key is derived from a server secret and the account ID when it is needed, so it never leaves
+// the process. The store has no conditional write, but alveoli instances creating the authority of an account
+// concurrently derive the same key and subject, so whichever certificate is kept validates all issued certificates.
+type Authority struct {
+	store  *store.Store
+	secret []byte
+	// mtx guards crls. It is never held while signing.
+	mtx  sync.Mutex
+	crls map[string]signedCRL
+}
+
+// New returns an authority persisting its certificates in s, and deriving the keys of account authorities from
+// secret. Certificates cannot be issued and revocation lists cannot be signed when secret is empty.
+func New(s *store.Store, secret []byte) *Authority {
+	return &Authority{store: s, secret: secret, crls: map[string]signedCRL{}}
+}
+
+// deriveKey returns the ECDSA P-256 private key of the authority of an account.
+func deriveKey(secret []byte, accountID string) *ecdsa.PrivateKey {
+	curve := elliptic.P256()
+	mac := hmac.New(sha512.New, secret)
+	mac.Write([]byte(keyDerivationInfo + accountID))
+	// Reducing 512 bits modulo the curve order minus one, then adding one, gives an unbiased scalar in [1, N-1].
+	max := new(big.Int).Sub(curve.Params().N, big.NewInt(1))
+	d := new(big.Int).SetBytes(mac.Sum(nil))
+	d.Mod(d, max).Add(d, big.NewInt(1))
+	key := &ecdsa.PrivateKey{D: d}
+	key.PublicKey.Curve = curve
+	key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(d.Bytes())
+	return key
+}
+
+// load returns the certificate authority of an account. It is created when missing and create is true, otherwise
+// ErrNoAuthority is returned. The private key is only set when the server has an authority secret.
+func (a *Authority) load(ctx context.Context, accountID string, create bool) (*tls.Certificate, error) {
+	var key *ecdsa.PrivateKey
+	if len(a.secret) > 0 {
+		key = deriveKey(a.secret, accountID)
+	}
+	data, err := a.store.Get(ctx, accountID, AuthorityStoreKind, authorityKey)
+	if err == store.ErrNotFound {
+		if !create {
+			return nil, ErrNoAuthority
+		}
+		if key == nil {
+			return nil, ErrDisabled
+		}
+		return a.create(ctx, accountID, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	stored := authority{}
+	err = json.Unmarshal(data, &stored)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(stored.Certificate))
+	if block == nil {
+		return nil, fmt.Errorf("invalid device certificate authority for account %s", accountID)
+	}
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	out := &tls.Certificate{Certificate: [][]byte{block.Bytes}, Leaf: leaf}
+	if key != nil {
+		public, ok := leaf.PublicKey.(*ecdsa.PublicKey)
+		if !ok || public.X.Cmp(key.X) != 0 || public.Y.Cmp(key.Y) != 0 {
+			return nil, fmt.Errorf("device certificate authority of account %s was not created with the current server secret", accountID)
+		}
+		out.PrivateKey = key
+	}
+	return out, nil
+}
+
+func (a *Authority) create(ctx context.Context, accountID string, key *ecdsa.PrivateKey) (*tls.Certificate, error) {
+	ca, err := rpc.GenerateCertificateAuthority(pkix.Name{
+		CommonName:   fmt.Sprintf("alveoli device CA %s", accountID),
+		Organization: []string{accountID},
+	}, time.Now().Add(authorityValidity), key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(authority{
+		Certificate: encodeCertificate(ca.Certificate[0]),
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = a.store.Put(ctx, accountID, AuthorityStoreKind, authorityKey, data)
+	if err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
+// Certificate returns the PEM encoded certificate of the account authority, which brokers must trust to
+// authenticate devices. ErrNoAuthority is returned until a certificate is issued for the account.
+func (a *Authority) Certificate(ctx context.Context, accountID string) (string, error) {
+	ca, err := a.load(ctx, accountID, false)
+	if err != nil {
+		return "", err
+	}
+	return encodeCertificate(ca.Certificate[0]), nil
+}
+
+// Issue signs a certificate for identity. The public key is taken from the PEM encoded csr, whose subject is
+// ignored: the common name is set to the profile MQTT username, the organization to the account ID and the
+// organizational unit to the application profile ID. A key pair is generated when csr is empty.
+func (a *Authority) Issue(ctx context.Context, identity Identity, csr string) (*Issued, error) {
+	if len(a.secret) == 0 {
+		return nil, ErrDisabled
+	}
+	out := &Issued{}
+	var publicKey crypto.PublicKey
+	if csr == "" {
+		key, err := rsa.GenerateKey(rand.Reader, minimumRSAKeySize)
+		if err != nil {
+			return nil, err
+		}
+		publicKey = key.Public()
+		out.PrivateKey = encodePrivateKey(key)
+	} else {
+		request, err := parseCSR(csr)
+		if err != nil {
+			return nil, err
+		}
+		publicKey = request.PublicKey
+	}
+	ca, err := a.load(ctx, identity.AccountID, true)
+	if err != nil {
+		return nil, err
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	notAfter := now.Add(certificateValidity)
+	if notAfter.After(ca.Leaf.NotAfter) {
+		notAfter = ca.Leaf.NotAfter
+	}
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName:         identity.Username,
+			Organization:       []string{identity.AccountID},
+			OrganizationalUnit: []string{identity.ApplicationProfileID},
+		},
+		NotBefore:   now,
+		NotAfter:    notAfter,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	body, err := x509.CreateCertificate(rand.Reader, template, ca.Leaf, publicKey, ca.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(body)
+	if err != nil {
+		return nil, err
+	}
+	out.Certificate = Certificate{
+		SerialNumber:         cert.SerialNumber.Text(16),
+		ApplicationID:        identity.ApplicationID,
+		ApplicationProfileID: identity.ApplicationProfileID,
+		Subject:              cert.Subject.String(),
+		NotBefore:            cert.NotBefore,
+		NotAfter:             cert.NotAfter,
+		Certificate:          encodeCertificate(body),
+	}
+	err = a.save(ctx, identity.AccountID, out.Certificate)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func parseCSR(csr string) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode([]byte(csr))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, &Error{Reason: "expected a PEM encoded CERTIFICATE REQUEST block"}
+	}
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, &Error{Reason: err.Error()}
+	}
+	err = request.CheckSignature()
+	if err != nil {
+		return nil, &Error{Reason: err.Error()}
+	}
+	if key, ok := request.PublicKey.(*rsa.PublicKey); ok && key.N.BitLen() < minimumRSAKeySize {
+		return nil, &Error{Reason: fmt.Sprintf("RSA keys must be at least %d bits long", minimumRSAKeySize)}
+	}
+	return request, nil
+}
+
+func (a *Authority) save(ctx context.Context, accountID string, cert Certificate) error {
+	data, err := json.Marshal(cert)
+	if err != nil {
+		return err
+	}
+	return a.store.Put(ctx, accountID, CertificateStoreKind, cert.SerialNumber, data)
+}
+
+// List returns the certificates issued for an account, oldest first.
+func (a *Authority) List(ctx context.Context, accountID string) ([]Certificate, error) {
+	values, err := a.store.List(ctx, accountID, CertificateStoreKind)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Certificate, 0, len(values))
+	for _, value := range values {
+		cert := Certificate{}
+		if json.Unmarshal(value, &cert) != nil {
+			continue
+		}
+		out = append(out, cert)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].NotBefore.Equal(out[j].NotBefore) {
+			return out[i].SerialNumber < out[j].SerialNumber
+		}
+		return out[i].NotBefore.Before(out[j].NotBefore)
+	})
+	return out, nil
+}
+
+// Revoke revokes the certificate with the given serial number. Revoking a certificate twice keeps the first
+// revocation date.
+func (a *Authority) Revoke(ctx context.Context, accountID, serialNumber string) (*Certificate, error) {
+	data, err := a.store.Get(ctx, accountID, CertificateStoreKind, serialNumber)
+	if err == store.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	cert := Certificate{}
+	err = json.Unmarshal(data, &cert)
+	if err != nil {
+		return nil, err
+	}
+	if cert.RevokedAt == nil {
+		now := time.Now()
+		cert.RevokedAt = &now
+		err = a.save(ctx, accountID, cert)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &cert, nil
+}
+
+// RevokeAll revokes the certificates of an account selected by match, and returns the number of revoked
+// certificates.
+func (a *Authority) RevokeAll(ctx context.Context, accountID string, match func(Certificate) bool) (int, error) {
+	certs, err := a.List(ctx, accountID)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, cert := range certs {
+		if cert.RevokedAt != nil || !match(cert) {
+			continue
+		}
+		_, err := a.Revoke(ctx, accountID, cert.SerialNumber)
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// CRL returns the DER encoded revocation list of an account, listing its revoked certificates which have not
+// expired yet. Brokers should fetch it again before its next update, one day after it was generated.
+//
+// Signed lists are cached, and only signed again when the revoked certificates change or half of their validity
+// has elapsed. Certificates revoked by other alveoli instances are listed once the store sees them.
+func (a *Authority) CRL(ctx context.Context, accountID string) ([]byte, error) {
+	ca, err := a.load(ctx, accountID, false)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := ca.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, ErrDisabled
+	}
+	certs, err := a.List(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	revoked := []pkix.RevokedCertificate{}
+	serialNumbers := []string{}
+	for _, cert := range certs {
+		if cert.RevokedAt == nil || cert.NotAfter.Before(now) {
+			continue
+		}
+		serialNumber, ok := new(big.Int).SetString(cert.SerialNumber, 16)
+		if !ok {
+			continue
+		}
+		revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: serialNumber, RevocationTime: *cert.RevokedAt})
+		serialNumbers = append(serialNumbers, cert.SerialNumber)
+	}
+	sort.Strings(serialNumbers)
+	key := strings.Join(serialNumbers, ",")
+
+	a.mtx.Lock()
+	cached, ok := a.crls[accountID]
+	a.mtx.Unlock()
+	if ok && cached.revoked == key && now.Before(cached.thisUpdate.Add(crlRefresh)) {
+		return cached.der, nil
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:              big.NewInt(now.UnixNano()),
+		ThisUpdate:          now,
+		NextUpdate:          now.Add(crlValidity),
+		RevokedCertificates: revoked,
+	}, ca.Leaf, signer)
+	if err != nil {
+		return nil, err
+	}
+	a.mtx.Lock()
+	a.crls[accountID] = signedCRL{revoked: key, thisUpdate: now, der: der}
+	a.mtx.Unlock()
+	return der, nil
+}
+
+func encodeCertificate(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func encodePrivateKey(key *rsa.PrivateKey) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
@@ -0,0 +1,302 @@
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/fakes"
+	"github.com/vx-labs/alveoli/alveoli/store"
+)
+
+var identity = Identity{
+	AccountID:            "account",
+	ApplicationID:        "application",
+	ApplicationProfileID: "profile",
+	Username:             "account/greenhouse/sensors",
+}
+
+func newAuthority(secret string) (*Authority, *store.Store) {
+	s := store.New(fakes.NewNest())
+	return New(s, []byte(secret)), s
+}
+
+func encodeCSR(t *testing.T, key interface{}) string {
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: "ignored"},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+func parseCertificate(t *testing.T, data string) *x509.Certificate {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		t.Fatal("expected a PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestDeriveKey(t *testing.T) {
+	key := deriveKey([]byte("secret"), "account")
+	if !key.Curve.IsOnCurve(key.X, key.Y) {
+		t.Fatal("expected the public key to be on the curve")
+	}
+	if other := deriveKey([]byte("secret"), "account"); other.D.Cmp(key.D) != 0 {
+		t.Error("expected the key to be derived deterministically")
+	}
+	if other := deriveKey([]byte("secret"), "other"); other.D.Cmp(key.D) == 0 {
+		t.Error("expected accounts to have different keys")
+	}
+	if other := deriveKey([]byte("other"), "account"); other.D.Cmp(key.D) == 0 {
+		t.Error("expected secrets to derive different keys")
+	}
+}
+
+func TestParseCSR(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shortKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := encodeCSR(t, ecKey)
+	block, _ := pem.Decode([]byte(valid))
+	tampered := append([]byte{}, block.Bytes...)
+	tampered[len(tampered)-1] ^= 0xff
+
+	for _, tc := range []struct {
+		name string
+		csr  string
+		err  string
+	}{
+		{name: "ecdsa", csr: valid},
+		{name: "not pem", csr: "garbage", err: "expected a PEM encoded CERTIFICATE REQUEST block"},
+		{name: "wrong block", csr: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: block.Bytes})), err: "expected a PEM encoded CERTIFICATE REQUEST block"},
+		{name: "invalid signature", csr: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: tampered})), err: "invalid certificate signing request"},
+		{name: "short rsa key", csr: encodeCSR(t, shortKey), err: "RSA keys must be at least 2048 bits long"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseCSR(tc.csr)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			var csrErr *Error
+			if !errors.As(err, &csrErr) || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected an error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestIssue(t *testing.T) {
+	ctx := context.Background()
+	authority, _ := newAuthority("secret")
+	if _, err := authority.Certificate(ctx, identity.AccountID); err != ErrNoAuthority {
+		t.Fatalf("expected ErrNoAuthority before the first issuance, got %v", err)
+	}
+	issued, err := authority.Issue(ctx, identity, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issued.PrivateKey == "" {
+		t.Error("expected a private key to be generated")
+	}
+	caPEM, err := authority.Certificate(ctx, identity.AccountID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(parseCertificate(t, caPEM))
+	cert := parseCertificate(t, issued.Certificate.Certificate)
+	_, err = cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	if err != nil {
+		t.Fatalf("expected the certificate to be signed by the account authority: %v", err)
+	}
+	if cert.Subject.CommonName != identity.Username ||
+		len(cert.Subject.Organization) != 1 || cert.Subject.Organization[0] != identity.AccountID ||
+		len(cert.Subject.OrganizationalUnit) != 1 || cert.Subject.OrganizationalUnit[0] != identity.ApplicationProfileID {
+		t.Errorf("unexpected subject %s", cert.Subject)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromCSR, err := authority.Issue(ctx, identity, encodeCSR(t, key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fromCSR.PrivateKey != "" {
+		t.Error("expected no private key when a CSR is provided")
+	}
+	if cert := parseCertificate(t, fromCSR.Certificate.Certificate); cert.Subject.CommonName != identity.Username {
+		t.Errorf("expected the CSR subject to be ignored, got %s", cert.Subject)
+	}
+
+	certs, err := authority.List(ctx, identity.AccountID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(certs) != 2 {
+		t.Fatalf("expected 2 certificates, got %d", len(certs))
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	ctx := context.Background()
+	authority, s := newAuthority("secret")
+	_, err := authority.Issue(ctx, identity, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	disabled := New(s, nil)
+	if _, err := disabled.Issue(ctx, identity, ""); err != ErrDisabled {
+		t.Errorf("expected ErrDisabled when issuing, got %v", err)
+	}
+	if _, err := disabled.CRL(ctx, identity.AccountID); err != ErrDisabled {
+		t.Errorf("expected ErrDisabled when signing a revocation list, got %v", err)
+	}
+	if _, err := disabled.Certificate(ctx, identity.AccountID); err != nil {
+		t.Errorf("expected the authority certificate to be readable without a secret, got %v", err)
+	}
+
+	rotated := New(s, []byte("other secret"))
+	if _, err := rotated.Issue(ctx, identity, ""); err == nil || !strings.Contains(err.Error(), "current server secret") {
+		t.Errorf("expected a secret mismatch to be detected, got %v", err)
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	ctx := context.Background()
+	authority, _ := newAuthority("secret")
+	if _, err := authority.Revoke(ctx, identity.AccountID, "1234"); err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	issued, err := authority.Issue(ctx, identity, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	revoked, err := authority.Revoke(ctx, identity.AccountID, issued.Certificate.SerialNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revoked.RevokedAt == nil {
+		t.Fatal("expected the certificate to be revoked")
+	}
+	again, err := authority.Revoke(ctx, identity.AccountID, issued.Certificate.SerialNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again.RevokedAt.Equal(*revoked.RevokedAt) {
+		t.Error("expected a second revocation to keep the first revocation date")
+	}
+
+	other := identity
+	other.ApplicationProfileID = "other"
+	for i := 0; i < 2; i++ {
+		if _, err := authority.Issue(ctx, other, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	count, err := authority.RevokeAll(ctx, identity.AccountID, func(cert Certificate) bool {
+		return cert.ApplicationProfileID == "other"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 revoked certificates, got %d", count)
+	}
+}
+
+func TestCRL(t *testing.T) {
+	ctx := context.Background()
+	authority, _ := newAuthority("secret")
+	if _, err := authority.CRL(ctx, identity.AccountID); err != ErrNoAuthority {
+		t.Fatalf("expected ErrNoAuthority, got %v", err)
+	}
+	issued, err := authority.Issue(ctx, identity, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	caPEM, err := authority.Certificate(ctx, identity.AccountID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca := parseCertificate(t, caPEM)
+
+	first, err := authority.CRL(ctx, identity.AccountID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := x509.ParseCRL(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ca.CheckCRLSignature(list); err != nil {
+		t.Fatalf("expected the list to be signed by the account authority: %v", err)
+	}
+	if len(list.TBSCertList.RevokedCertificates) != 0 {
+		t.Fatalf("expected an empty list, got %d entries", len(list.TBSCertList.RevokedCertificates))
+	}
+	cached, err := authority.CRL(ctx, identity.AccountID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first, cached) {
+		t.Error("expected the signed list to be cached")
+	}
+
+	_, err = authority.Revoke(ctx, identity.AccountID, issued.Certificate.SerialNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revoked, err := authority.CRL(ctx, identity.AccountID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err = x509.ParseCRL(revoked)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.TBSCertList.RevokedCertificates) != 1 ||
+		list.TBSCertList.RevokedCertificates[0].SerialNumber.Text(16) != issued.Certificate.SerialNumber {
+		t.Fatalf("expected the revoked certificate to be listed, got %+v", list.TBSCertList.RevokedCertificates)
+	}
+
+	authority.mtx.Lock()
+	signed := authority.crls[identity.AccountID]
+	signed.thisUpdate = time.Now().Add(-crlRefresh)
+	authority.crls[identity.AccountID] = signed
+	authority.mtx.Unlock()
+	refreshed, err := authority.CRL(ctx, identity.AccountID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(refreshed, revoked) {
+		t.Error("expected the list to be signed again after half of its validity")
+	}
+}
@@ -0,0 +1,101 @@
+// Package client calls the GraphQL API of a running alveoli server.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// GraphQLError is returned when the GraphQL API answered with errors.
+type GraphQLError struct {
+	Messages []string
+}
+
+func (e *GraphQLError) Error() string {
+	return fmt.Sprintf("graphql: %s", strings.Join(e.Messages, "; "))
+}
+
+// Client sends authenticated requests to an alveoli server.
+type Client struct {
+	endpoint string
+	token    string
+	http     *http.Client
+}
+
+// New returns a client of the alveoli server listening on endpoint, such as "https://api.example.net", and
+// authenticating with the bearer token.
+func New(endpoint, token string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{endpoint: strings.TrimSuffix(endpoint, "/"), token: token, http: httpClient}
+}
+
+// Endpoint returns the URL of the alveoli server.
+func (c *Client) Endpoint() string {
+	return c.endpoint
+}
+
+// Do sends an authenticated request to path, encoding body as JSON when it is not nil.
+func (c *Client) Do(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	var payload io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		payload = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, payload)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return c.http.Do(req)
+}
+
+// GraphQL runs query with the given variables, and decodes the response data in out when it is not nil.
+func (c *Client) GraphQL(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
+	resp, err := c.Do(ctx, http.MethodPost, "/graphql", map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("graphql: unexpected status code %d: %s", resp.StatusCode, body)
+	}
+	response := struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}{}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		out := &GraphQLError{Messages: make([]string, len(response.Errors))}
+		for idx, e := range response.Errors {
+			out.Messages[idx] = e.Message
+		}
+		return out
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(response.Data, out)
+}
@@ -0,0 +1,202 @@
+// Package configuration describes the applications and application profiles of an account as a YAML or JSON
+// document, and computes the changes needed to make vespiary match it.
+package configuration
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	"github.com/vx-labs/alveoli/alveoli/provisioning"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Document is the desired configuration of an account.
+//
+//	applications:
+//	  - name: greenhouse
+//	    profiles:
+//	      - name: sensors
+//	        password: "..."
+type Document struct {
+	Applications []Application `yaml:"applications"`
+}
+
+// Application is an application and its profiles.
+type Application struct {
+	Name     string    `yaml:"name"`
+	Profiles []Profile `yaml:"profiles"`
+}
+
+// Profile is an application profile. Password is only required to create the profile. Vespiary cannot update
+// profiles, so the password of an existing profile can only be omitted or repeated: changing it is refused, and the
+// profile must be deleted first.
+type Profile struct {
+	Name     string `yaml:"name"`
+	Password string `yaml:"password"`
+}
+
+// Error describes an invalid configuration document.
+type Error struct {
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid configuration: %s", e.Reason)
+}
+
+// Parse decodes and validates a YAML or JSON configuration document. Unknown fields are rejected.
+func Parse(data []byte) (*Document, error) {
+	doc := &Document{}
+	err := yaml.UnmarshalStrict(data, doc)
+	if err != nil {
+		return nil, &Error{Reason: err.Error()}
+	}
+	applications := map[string]struct{}{}
+	for _, application := range doc.Applications {
+		if application.Name == "" {
+			return nil, &Error{Reason: "applications must have a name"}
+		}
+		if err := provisioning.ValidateName("application", application.Name); err != nil {
+			return nil, &Error{Reason: err.Error()}
+		}
+		if _, ok := applications[application.Name]; ok {
+			return nil, &Error{Reason: fmt.Sprintf("application %q is declared twice", application.Name)}
+		}
+		applications[application.Name] = struct{}{}
+		profiles := map[string]struct{}{}
+		for _, profile := range application.Profiles {
+			if profile.Name == "" {
+				return nil, &Error{Reason: fmt.Sprintf("profiles of application %q must have a name", application.Name)}
+			}
+			if err := provisioning.ValidateName("application profile", profile.Name); err != nil {
+				return nil, &Error{Reason: err.Error()}
+			}
+			if _, ok := profiles[profile.Name]; ok {
+				return nil, &Error{Reason: fmt.Sprintf("profile %q of application %q is declared twice", profile.Name, application.Name)}
+			}
+			profiles[profile.Name] = struct{}{}
+		}
+	}
+	return doc, nil
+}
+
+// Kind is the kind of resource affected by a change.
+type Kind string
+
+const (
+	KindApplication        Kind = "APPLICATION"
+	KindApplicationProfile Kind = "APPLICATION_PROFILE"
+)
+
+// Action is the operation needed to reconcile a resource.
+type Action string
+
+const (
+	Create    Action = "CREATE"
+	Delete    Action = "DELETE"
+	Unchanged Action = "UNCHANGED"
+)
+
+// Change is an operation on a single resource. ID is the ID of the existing resource, and is empty for creations.
+type Change struct {
+	Kind        Kind
+	Action      Action
+	Application string
+	Name        string
+	ID          string
+	Reason      string
+	// Password is the password of created profiles.
+	Password string
+}
+
+// PasswordMatches returns true if password is the password of profile.
+func PasswordMatches(profile *vespiary.ApplicationProfile, password string) bool {
+	sum := sha256.Sum256(append([]byte(password), profile.PasswordSalt...))
+	return bytes.Equal(sum[:], profile.PasswordFingerprint)
+}
+
+// Diff returns the changes needed to make the existing applications and profiles match doc, in the order they must
+// be applied: creations first, then deletions of profiles before the deletions of their
+// application. Resources missing from doc are only deleted when prune is true.
+func Diff(doc *Document, applications []*vespiary.Application, profiles []*vespiary.ApplicationProfile, prune bool) ([]Change, error) {
+	existingApplications := map[string]*vespiary.Application{}
+	applicationNames := map[string]string{}
+	for _, application := range applications {
+		existingApplications[application.Name] = application
+		applicationNames[application.ID] = application.Name
+	}
+	existingProfiles := map[string]map[string]*vespiary.ApplicationProfile{}
+	for _, profile := range profiles {
+		name := applicationNames[profile.ApplicationID]
+		if existingProfiles[name] == nil {
+			existingProfiles[name] = map[string]*vespiary.ApplicationProfile{}
+		}
+		existingProfiles[name][profile.Name] = profile
+	}
+
+	changes := []Change{}
+	deletions := []Change{}
+	declared := map[string]struct{}{}
+	for _, application := range doc.Applications {
+		declared[application.Name] = struct{}{}
+		change := Change{Kind: KindApplication, Action: Unchanged, Application: application.Name, Name: application.Name}
+		if existing, ok := existingApplications[application.Name]; ok {
+			change.ID = existing.ID
+		} else {
+			change.Action = Create
+		}
+		changes = append(changes, change)
+
+		declaredProfiles := map[string]struct{}{}
+		for _, profile := range application.Profiles {
+			declaredProfiles[profile.Name] = struct{}{}
+			change := Change{Kind: KindApplicationProfile, Action: Unchanged, Application: application.Name, Name: profile.Name, Password: profile.Password}
+			existing, ok := existingProfiles[application.Name][profile.Name]
+			switch {
+			case !ok:
+				if profile.Password == "" {
+					return nil, &Error{Reason: fmt.Sprintf("profile %q of application %q does not exist and needs a password", profile.Name, application.Name)}
+				}
+				change.Action = Create
+			case profile.Password != "" && !PasswordMatches(existing, profile.Password):
+				return nil, &Error{Reason: fmt.Sprintf("the password of profile %q of application %q cannot be changed: delete the profile first", profile.Name, application.Name)}
+			default:
+				change.ID = existing.ID
+			}
+			changes = append(changes, change)
+		}
+		if prune {
+			for _, existing := range sortedProfiles(existingProfiles[application.Name]) {
+				if _, ok := declaredProfiles[existing.Name]; !ok {
+					deletions = append(deletions, Change{Kind: KindApplicationProfile, Action: Delete, Application: application.Name, Name: existing.Name, ID: existing.ID, Reason: "not declared"})
+				}
+			}
+		}
+	}
+	if prune {
+		sorted := append([]*vespiary.Application{}, applications...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+		for _, existing := range sorted {
+			if _, ok := declared[existing.Name]; ok {
+				continue
+			}
+			for _, profile := range sortedProfiles(existingProfiles[existing.Name]) {
+				deletions = append(deletions, Change{Kind: KindApplicationProfile, Action: Delete, Application: existing.Name, Name: profile.Name, ID: profile.ID, Reason: "application not declared"})
+			}
+			deletions = append(deletions, Change{Kind: KindApplication, Action: Delete, Application: existing.Name, Name: existing.Name, ID: existing.ID, Reason: "not declared"})
+		}
+	}
+	return append(changes, deletions...), nil
+}
+
+func sortedProfiles(profiles map[string]*vespiary.ApplicationProfile) []*vespiary.ApplicationProfile {
+	out := make([]*vespiary.ApplicationProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		out = append(out, profile)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
@@ -0,0 +1,152 @@
+package configuration
+
+import (
+	"crypto/sha256"
+	"errors"
+	"strings"
+	"testing"
+
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{name: "yaml", input: "applications:\n  - name: greenhouse\n    profiles:\n      - name: sensors\n        password: secret-password\n"},
+		{name: "json", input: `{"applications": [{"name": "greenhouse", "profiles": [{"name": "sensors"}]}]}`},
+		{name: "empty", input: ""},
+		{name: "unknown field", input: "applications:\n  - name: greenhouse\n    owner: me\n", err: "owner"},
+		{name: "application without name", input: "applications:\n  - profiles: []\n", err: "applications must have a name"},
+		{name: "profile without name", input: "applications:\n  - name: greenhouse\n    profiles:\n      - password: secret-password\n", err: `profiles of application "greenhouse" must have a name`},
+		{name: "invalid application name", input: "applications:\n  - name: green/house\n", err: "must not contain /"},
+		{name: "invalid profile name", input: "applications:\n  - name: greenhouse\n    profiles:\n      - name: sen/sors\n", err: "must not contain /"},
+		{name: "duplicate application", input: "applications:\n  - name: greenhouse\n  - name: greenhouse\n", err: `application "greenhouse" is declared twice`},
+		{name: "duplicate profile", input: "applications:\n  - name: greenhouse\n    profiles:\n      - name: sensors\n      - name: sensors\n", err: `profile "sensors" of application "greenhouse" is declared twice`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse([]byte(tc.input))
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error containing %q", tc.err)
+			}
+			var configErr *Error
+			if !errors.As(err, &configErr) {
+				t.Errorf("expected a configuration error, got %T", err)
+			}
+			if !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected an error containing %q, got %q", tc.err, err.Error())
+			}
+		})
+	}
+}
+
+func profile(id, applicationID, name, password string) *vespiary.ApplicationProfile {
+	salt := []byte("salt-" + id)
+	sum := sha256.Sum256(append([]byte(password), salt...))
+	return &vespiary.ApplicationProfile{ID: id, ApplicationID: applicationID, Name: name, PasswordSalt: salt, PasswordFingerprint: sum[:]}
+}
+
+func TestPasswordMatches(t *testing.T) {
+	p := profile("p1", "a1", "sensors", "secret-password")
+	if !PasswordMatches(p, "secret-password") {
+		t.Error("expected the password to match")
+	}
+	if PasswordMatches(p, "other-password") {
+		t.Error("expected another password not to match")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	applications := []*vespiary.Application{
+		{ID: "a1", Name: "greenhouse"},
+		{ID: "a2", Name: "barn"},
+	}
+	profiles := []*vespiary.ApplicationProfile{
+		profile("p1", "a1", "sensors", "secret-password"),
+		profile("p2", "a1", "valves", "secret-password"),
+		profile("p3", "a2", "doors", "secret-password"),
+	}
+	for _, tc := range []struct {
+		name    string
+		doc     string
+		prune   bool
+		changes []string
+		err     string
+	}{
+		{
+			name: "unchanged",
+			doc:  "applications:\n  - name: greenhouse\n    profiles:\n      - name: sensors\n        password: secret-password\n      - name: valves\n",
+			changes: []string{
+				"UNCHANGED APPLICATION greenhouse/greenhouse a1",
+				"UNCHANGED APPLICATION_PROFILE greenhouse/sensors p1",
+				"UNCHANGED APPLICATION_PROFILE greenhouse/valves p2",
+			},
+		},
+		{
+			name: "create",
+			doc:  "applications:\n  - name: greenhouse\n    profiles:\n      - name: pumps\n        password: secret-password\n  - name: silo\n    profiles:\n      - name: levels\n        password: secret-password\n",
+			changes: []string{
+				"UNCHANGED APPLICATION greenhouse/greenhouse a1",
+				"CREATE APPLICATION_PROFILE greenhouse/pumps ",
+				"CREATE APPLICATION silo/silo ",
+				"CREATE APPLICATION_PROFILE silo/levels ",
+			},
+		},
+		{
+			name:  "prune",
+			doc:   "applications:\n  - name: greenhouse\n    profiles:\n      - name: sensors\n",
+			prune: true,
+			changes: []string{
+				"UNCHANGED APPLICATION greenhouse/greenhouse a1",
+				"UNCHANGED APPLICATION_PROFILE greenhouse/sensors p1",
+				"DELETE APPLICATION_PROFILE greenhouse/valves p2",
+				"DELETE APPLICATION_PROFILE barn/doors p3",
+				"DELETE APPLICATION barn/barn a2",
+			},
+		},
+		{
+			name: "missing password",
+			doc:  "applications:\n  - name: greenhouse\n    profiles:\n      - name: pumps\n",
+			err:  `profile "pumps" of application "greenhouse" does not exist and needs a password`,
+		},
+		{
+			name: "password changed",
+			doc:  "applications:\n  - name: greenhouse\n    profiles:\n      - name: sensors\n        password: other-password\n",
+			err:  `the password of profile "sensors" of application "greenhouse" cannot be changed`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := Parse([]byte(tc.doc))
+			if err != nil {
+				t.Fatal(err)
+			}
+			changes, err := Diff(doc, applications, profiles, tc.prune)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected an error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) != len(tc.changes) {
+				t.Fatalf("expected %d changes, got %d: %+v", len(tc.changes), len(changes), changes)
+			}
+			for idx, change := range changes {
+				got := string(change.Action) + " " + string(change.Kind) + " " + change.Application + "/" + change.Name + " " + change.ID
+				if got != tc.changes[idx] {
+					t.Errorf("change %d: expected %q, got %q", idx, tc.changes[idx], got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package decoding
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Format is a payload serialization format.
+type Format string
+
+const (
+	JSON        Format = "JSON"
+	CBOR        Format = "CBOR"
+	MessagePack Format = "MSGPACK"
+	Protobuf    Format = "PROTOBUF"
+)
+
+// ErrUnknownFormat is returned when a payload format could not be detected.
+var ErrUnknownFormat = errors.New("unknown payload format")
+
+// Error is returned when a payload or a protobuf descriptor set provided by the user cannot be decoded.
+type Error struct {
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return e.Reason
+}
+
+// Decode decodes payload using the given format, and returns a value that can be serialized as JSON.
+// message is only required when decoding protobuf payloads.
+func Decode(format Format, payload []byte, message protoreflect.MessageDescriptor) (interface{}, error) {
+	out, err := decode(format, payload, message)
+	if err != nil {
+		return nil, &Error{Reason: fmt.Sprintf("invalid %s payload: %v", format, err)}
+	}
+	return out, nil
+}
+
+func decode(format Format, payload []byte, message protoreflect.MessageDescriptor) (interface{}, error) {
+	switch format {
+	case JSON:
+		var out interface{}
+		err := json.Unmarshal(payload, &out)
+		return out, err
+	case CBOR:
+		var out interface{}
+		decoder := cbor.NewDecoder(bytes.NewReader(payload))
+		err := decoder.Decode(&out)
+		if err != nil {
+			return nil, err
+		}
+		if decoder.NumBytesRead() != len(payload) {
+			return nil, errors.New("cbor: extraneous data after value")
+		}
+		return normalize(out), nil
+	case MessagePack:
+		var out interface{}
+		reader := bytes.NewReader(payload)
+		err := msgpack.NewDecoder(reader).Decode(&out)
+		if err != nil {
+			return nil, err
+		}
+		if reader.Len() != 0 {
+			return nil, errors.New("msgpack: extraneous data after value")
+		}
+		return normalize(out), nil
+	case Protobuf:
+		if message == nil {
+			return nil, errors.New("a protobuf message type is required to decode protobuf payloads")
+		}
+		msg := dynamicpb.NewMessage(message)
+		err := proto.Unmarshal(payload, msg)
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := protojson.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		var out interface{}
+		err = json.Unmarshal(encoded, &out)
+		return out, err
+	default:
+		return nil, fmt.Errorf("unsupported payload format %q", format)
+	}
+}
+
+// Detect decodes payload using the first format able to decode it.
+//
+// Like nest's Topic.guessedContentType, the content type is first sniffed using http.DetectContentType:
+// text payloads are only decoded as JSON, while binary payloads are tried as protobuf (when message is not nil),
+// CBOR, and MessagePack.
+func Detect(payload []byte, message protoreflect.MessageDescriptor) (interface{}, Format, error) {
+	if len(payload) == 0 {
+		return nil, "", ErrUnknownFormat
+	}
+	if strings.HasPrefix(http.DetectContentType(payload), "text/") {
+		if json.Valid(payload) {
+			out, err := Decode(JSON, payload, nil)
+			return out, JSON, err
+		}
+		return nil, "", ErrUnknownFormat
+	}
+	candidates := []Format{CBOR, MessagePack}
+	if message != nil {
+		candidates = append([]Format{Protobuf}, candidates...)
+	}
+	for _, format := range candidates {
+		out, err := Decode(format, payload, message)
+		if err == nil {
+			return out, format, nil
+		}
+	}
+	return nil, "", ErrUnknownFormat
+}
+
+// ParseDescriptorSet parses a serialized google.protobuf.FileDescriptorSet, as produced by
+// "protoc --include_imports --descriptor_set_out".
+func ParseDescriptorSet(data []byte) (*protoregistry.Files, error) {
+	set := &descriptorpb.FileDescriptorSet{}
+	err := proto.Unmarshal(data, set)
+	if err != nil {
+		return nil, &Error{Reason: fmt.Sprintf("invalid descriptor set: %v", err)}
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, &Error{Reason: fmt.Sprintf("invalid descriptor set: %v", err)}
+	}
+	return files, nil
+}
+
+// FindMessage returns the descriptor of the message named name in files.
+func FindMessage(files *protoregistry.Files, name string) (protoreflect.MessageDescriptor, error) {
+	descriptor, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, &Error{Reason: fmt.Sprintf("message type %q not found", name)}
+	}
+	message, ok := descriptor.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, &Error{Reason: fmt.Sprintf("%q is not a message type", name)}
+	}
+	return message, nil
+}
+
+// MessageNames returns the full names of all the messages declared in files.
+func MessageNames(files *protoregistry.Files) []string {
+	out := []string{}
+	var walk func(messages protoreflect.MessageDescriptors)
+	walk = func(messages protoreflect.MessageDescriptors) {
+		for i := 0; i < messages.Len(); i++ {
+			message := messages.Get(i)
+			if message.IsMapEntry() {
+				continue
+			}
+			out = append(out, string(message.FullName()))
+			walk(message.Messages())
+		}
+	}
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		walk(file.Messages())
+		return true
+	})
+	sort.Strings(out)
+	return out
+}
+
+// normalize converts values produced by the CBOR and MessagePack decoders into values that can be serialized as JSON.
+func normalize(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			out[fmt.Sprint(k)] = normalize(v)
+		}
+		return out
+	case map[string]interface{}:
+		for k, v := range value {
+			value[k] = normalize(v)
+		}
+		return value
+	case []interface{}:
+		for idx := range value {
+			value[idx] = normalize(value[idx])
+		}
+		return value
+	case []byte:
+		return base64.StdEncoding.EncodeToString(value)
+	case cbor.Tag:
+		return normalize(value.Content)
+	default:
+		return value
+	}
+}
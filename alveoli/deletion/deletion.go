@@ -0,0 +1,448 @@
+// Package deletion removes an account and the resources it owns in vespiary, wasp, nest and the alveoli store.
+//
+// Deleting an account is a two-step operation: a deletion is first requested, returning a confirmation token,
+// and then confirmed with this token. The progress of each step is persisted, so a failed deletion can be
+// resumed with the same token.
+//
+// A deletion runs once at a time: confirming it while it is running fails with ErrInProgress. Running deletions
+// save their progress at least every RunLease, and a deletion that stopped doing so, for instance because its
+// server crashed, can be resumed.
+//
+// Some steps cannot be carried out by the backends yet: wasp cannot disconnect sessions and nest cannot delete
+// records. Such steps are skipped, and the deletion ends in the Incomplete status instead of Completed.
+package deletion
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/store"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// StoreKind is the store namespace holding account deletions.
+	StoreKind = "account-deletions"
+	storeKey  = "current"
+	// ConfirmationTTL is the time a user has to confirm a requested deletion.
+	ConfirmationTTL = 15 * time.Minute
+	// RunLease is the time after which a running deletion that did not save its progress is considered abandoned.
+	RunLease = time.Minute
+)
+
+var (
+	// ErrNotRequested is returned when a deletion is confirmed before being requested.
+	ErrNotRequested = errors.New("account deletion was not requested")
+	// ErrInvalidConfirmation is returned when the confirmation token is wrong or expired.
+	ErrInvalidConfirmation = errors.New("invalid or expired confirmation token")
+	// ErrInProgress is returned when a deletion is confirmed while it is running.
+	ErrInProgress = errors.New("account deletion is already in progress")
+)
+
+// StepName identifies a step of the deletion.
+type StepName string
+
+const (
+	DisconnectSessions        StepName = "DISCONNECT_SESSIONS"
+	DeleteApplicationProfiles StepName = "DELETE_APPLICATION_PROFILES"
+	DeleteApplications        StepName = "DELETE_APPLICATIONS"
+	PurgeRecords              StepName = "PURGE_RECORDS"
+	DeleteConfiguration       StepName = "DELETE_CONFIGURATION"
+	DeleteAccount             StepName = "DELETE_ACCOUNT"
+)
+
+// State is the progress of a step.
+type State string
+
+const (
+	Pending State = "PENDING"
+	Done    State = "DONE"
+	Failed  State = "FAILED"
+	// Skipped steps could not be carried out by the backends. Their message explains what was left behind.
+	Skipped State = "SKIPPED"
+)
+
+// Status is the overall progress of a deletion.
+type Status string
+
+const (
+	// StatusRequested deletions wait for their confirmation.
+	StatusRequested  Status = "REQUESTED"
+	StatusInProgress Status = "IN_PROGRESS"
+	// StatusFailed deletions stopped on a failed step, and can be resumed.
+	StatusFailed Status = "FAILED"
+	// StatusIncomplete deletions carried out all the steps they could, but skipped some of them and left data behind.
+	StatusIncomplete Status = "INCOMPLETE"
+	StatusCompleted  Status = "COMPLETED"
+)
+
+// Step is a step of the deletion, and the number of resources it processed and has left to process.
+type Step struct {
+	Name      StepName
+	State     State
+	Processed int
+	Remaining int
+	Message   string
+}
+
+// Job is the deletion of an account. CompletedAt is only set once all the steps are done, while FinishedAt
+// is set once all the steps were carried out, including the skipped ones. UpdatedAt is set each time the job is saved.
+type Job struct {
+	AccountID        string
+	ConfirmationHash string
+	RequestedAt      time.Time
+	ExpiresAt        time.Time
+	UpdatedAt        time.Time
+	ConfirmedAt      *time.Time
+	FinishedAt       *time.Time
+	CompletedAt      *time.Time
+	Steps            []*Step
+}
+
+// Status returns the overall progress of the deletion.
+func (j *Job) Status() Status {
+	if j.ConfirmedAt == nil {
+		return StatusRequested
+	}
+	skipped := false
+	for _, step := range j.Steps {
+		switch step.State {
+		case Failed:
+			return StatusFailed
+		case Pending:
+			return StatusInProgress
+		case Skipped:
+			skipped = true
+		}
+	}
+	if skipped {
+		return StatusIncomplete
+	}
+	return StatusCompleted
+}
+
+// Deleter runs account deletions.
+type Deleter struct {
+	store    *store.Store
+	vespiary vespiary.VespiaryClient
+	wasp     wasp.MQTTClient
+	nest     nest.MessagesClient
+	mtx      sync.Mutex
+	running  map[string]struct{}
+}
+
+// NewDeleter returns a deleter removing resources from the provided backends.
+func NewDeleter(configuration *store.Store, vespiaryClient vespiary.VespiaryClient, waspClient wasp.MQTTClient, nestClient nest.MessagesClient) *Deleter {
+	return &Deleter{store: configuration, vespiary: vespiaryClient, wasp: waspClient, nest: nestClient, running: map[string]struct{}{}}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func isNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+// Load returns the last deletion of the account, or nil.
+func (d *Deleter) Load(ctx context.Context, accountID string) (*Job, error) {
+	data, err := d.store.Get(ctx, accountID, StoreKind, storeKey)
+	if err == store.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	job := &Job{}
+	return job, json.Unmarshal(data, job)
+}
+
+func (d *Deleter) save(ctx context.Context, job *Job) error {
+	job.UpdatedAt = time.Now()
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return d.store.Put(ctx, job.AccountID, StoreKind, storeKey, data)
+}
+
+// Request plans the deletion of an account, and returns it with the token needed to confirm it.
+// Requesting again replaces the token; steps already carried out are quickly done again when confirming.
+// Running deletions cannot be requested again.
+func (d *Deleter) Request(ctx context.Context, accountID string) (*Job, string, error) {
+	d.mtx.Lock()
+	_, running := d.running[accountID]
+	d.mtx.Unlock()
+	if running {
+		return nil, "", ErrInProgress
+	}
+	current, err := d.Load(ctx, accountID)
+	if err != nil {
+		return nil, "", err
+	}
+	if current != nil && current.Status() == StatusInProgress && time.Since(current.UpdatedAt) < RunLease {
+		return nil, "", ErrInProgress
+	}
+	buf := make([]byte, 24)
+	_, err = rand.Read(buf)
+	if err != nil {
+		return nil, "", err
+	}
+	token := hex.EncodeToString(buf)
+	now := time.Now()
+	job := &Job{
+		AccountID:        accountID,
+		ConfirmationHash: hashToken(token),
+		RequestedAt:      now,
+		ExpiresAt:        now.Add(ConfirmationTTL),
+	}
+	for _, name := range []StepName{DisconnectSessions, DeleteApplicationProfiles, DeleteApplications, PurgeRecords, DeleteConfiguration, DeleteAccount} {
+		job.Steps = append(job.Steps, &Step{Name: name, State: Pending})
+	}
+	err = d.plan(ctx, job)
+	if err != nil {
+		return nil, "", err
+	}
+	err = d.save(ctx, job)
+	if err != nil {
+		return nil, "", err
+	}
+	return job, token, nil
+}
+
+// plan counts the resources each step will remove.
+func (d *Deleter) plan(ctx context.Context, job *Job) error {
+	for _, step := range job.Steps {
+		var err error
+		switch step.Name {
+		case DisconnectSessions:
+			var sessions []*wasp.SessionMetadatas
+			sessions, err = d.sessions(ctx, job.AccountID)
+			step.Remaining = len(sessions)
+		case DeleteApplicationProfiles:
+			var out *vespiary.ListApplicationProfilesByAccountIDResponse
+			out, err = d.vespiary.ListApplicationProfilesByAccountID(ctx, &vespiary.ListApplicationProfilesByAccountIDRequest{AccountID: job.AccountID})
+			if err == nil {
+				step.Remaining = len(out.ApplicationProfiles)
+			}
+		case DeleteApplications:
+			var out *vespiary.ListApplicationsByAccountIDResponse
+			out, err = d.vespiary.ListApplicationsByAccountID(ctx, &vespiary.ListApplicationsByAccountIDRequest{AccountID: job.AccountID})
+			if err == nil {
+				step.Remaining = len(out.Applications)
+			}
+		case PurgeRecords:
+			step.Remaining, _, err = d.records(ctx, job.AccountID)
+		case DeleteAccount:
+			step.Remaining = 1
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run confirms the deletion of an account, and carries out its steps until one of them fails.
+// Failed and incomplete deletions are resumed by calling Run again with the same token: skipped steps
+// are attempted again, since the data they left behind may be gone.
+//
+// Deletions of the same account are serialized in this process. Other alveoli servers are detected through the
+// progress they save, which the store does not update atomically: two servers confirming the same deletion at the
+// same instant may still both run it, and steps are written to tolerate it.
+func (d *Deleter) Run(ctx context.Context, accountID, token string) (*Job, error) {
+	d.mtx.Lock()
+	if _, ok := d.running[accountID]; ok {
+		d.mtx.Unlock()
+		return nil, ErrInProgress
+	}
+	d.running[accountID] = struct{}{}
+	d.mtx.Unlock()
+	defer func() {
+		d.mtx.Lock()
+		delete(d.running, accountID)
+		d.mtx.Unlock()
+	}()
+
+	job, err := d.Load(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, ErrNotRequested
+	}
+	if subtle.ConstantTimeCompare([]byte(hashToken(token)), []byte(job.ConfirmationHash)) != 1 {
+		return nil, ErrInvalidConfirmation
+	}
+	now := time.Now()
+	if job.Status() == StatusInProgress && now.Sub(job.UpdatedAt) < RunLease {
+		return nil, ErrInProgress
+	}
+	if job.ConfirmedAt == nil {
+		if now.After(job.ExpiresAt) {
+			return nil, ErrInvalidConfirmation
+		}
+		job.ConfirmedAt = &now
+	}
+	// Saving the job before running its steps reports it as in progress to the other servers.
+	err = d.save(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range job.Steps {
+		if step.State == Done {
+			continue
+		}
+		step.Message = ""
+		err := d.runStep(ctx, job, step)
+		if err != nil {
+			step.State = Failed
+			step.Message = err.Error()
+			return job, d.save(ctx, job)
+		}
+		err = d.save(ctx, job)
+		if err != nil {
+			return nil, err
+		}
+	}
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+	if job.Status() == StatusCompleted {
+		job.CompletedAt = &finishedAt
+	}
+	return job, d.save(ctx, job)
+}
+
+func (d *Deleter) sessions(ctx context.Context, accountID string) ([]*wasp.SessionMetadatas, error) {
+	out, err := d.wasp.ListSessionMetadatas(ctx, &wasp.ListSessionMetadatasRequest{})
+	if err != nil {
+		return nil, err
+	}
+	sessions := []*wasp.SessionMetadatas{}
+	for _, session := range out.SessionMetadatasList {
+		if tenancy.OwnsMountPoint(accountID, "", session.MountPoint) {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+// records returns the number and the size of the records stored for the account.
+func (d *Deleter) records(ctx context.Context, accountID string) (int, int, error) {
+	out, err := d.nest.ListTopics(ctx, &nest.ListTopicsRequest{
+		Pattern: tenancy.TopicPattern(accountID, tenancy.AnyApplication, "#"),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	count, size := 0, 0
+	for _, metadata := range out.TopicMetadatas {
+		count += int(metadata.MessageCount)
+		size += int(metadata.SizeInBytes)
+	}
+	return count, size, nil
+}
+
+func (d *Deleter) runStep(ctx context.Context, job *Job, step *Step) error {
+	switch step.Name {
+	case DisconnectSessions:
+		sessions, err := d.sessions(ctx, job.AccountID)
+		if err != nil {
+			return err
+		}
+		step.Remaining = len(sessions)
+		if len(sessions) == 0 {
+			step.State = Done
+			return nil
+		}
+		step.State = Skipped
+		step.Message = fmt.Sprintf("the broker cannot disconnect sessions: %d sessions stay connected until they disconnect, and cannot reconnect once application profiles are deleted", len(sessions))
+		return nil
+	case DeleteApplicationProfiles:
+		out, err := d.vespiary.ListApplicationProfilesByAccountID(ctx, &vespiary.ListApplicationProfilesByAccountIDRequest{AccountID: job.AccountID})
+		if err != nil {
+			return err
+		}
+		step.Remaining = len(out.ApplicationProfiles)
+		for _, profile := range out.ApplicationProfiles {
+			_, err := d.vespiary.DeleteApplicationProfileByAccountID(ctx, &vespiary.DeleteApplicationProfileByAccountIDRequest{
+				AccountID: job.AccountID,
+				ID:        profile.ID,
+			})
+			if err != nil && !isNotFound(err) {
+				return err
+			}
+			step.Processed++
+			step.Remaining--
+			err = d.save(ctx, job)
+			if err != nil {
+				return err
+			}
+		}
+	case DeleteApplications:
+		out, err := d.vespiary.ListApplicationsByAccountID(ctx, &vespiary.ListApplicationsByAccountIDRequest{AccountID: job.AccountID})
+		if err != nil {
+			return err
+		}
+		step.Remaining = len(out.Applications)
+		for _, application := range out.Applications {
+			_, err := d.vespiary.DeleteApplicationByAccountID(ctx, &vespiary.DeleteApplicationByAccountIDRequest{
+				AccountID: job.AccountID,
+				ID:        application.ID,
+			})
+			if err != nil && !isNotFound(err) {
+				return err
+			}
+			step.Processed++
+			step.Remaining--
+			err = d.save(ctx, job)
+			if err != nil {
+				return err
+			}
+		}
+	case PurgeRecords:
+		count, size, err := d.records(ctx, job.AccountID)
+		if err != nil {
+			return err
+		}
+		step.Remaining = count
+		if count == 0 {
+			step.State = Done
+			return nil
+		}
+		step.State = Skipped
+		step.Message = fmt.Sprintf("the record store cannot delete records: %d records (%d bytes) are left in storage", count, size)
+		return nil
+	case DeleteConfiguration:
+		count, err := d.store.DeleteAll(ctx, job.AccountID, StoreKind)
+		step.Processed += count
+		if err != nil {
+			return err
+		}
+	case DeleteAccount:
+		_, err := d.vespiary.DeleteAccount(ctx, &vespiary.DeleteAccountRequest{ID: job.AccountID})
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+		step.Processed = 1
+		step.Remaining = 0
+	default:
+		return fmt.Errorf("unknown deletion step %q", step.Name)
+	}
+	step.State = Done
+	return nil
+}
@@ -0,0 +1,92 @@
+package deletion
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/fakes"
+	"github.com/vx-labs/alveoli/alveoli/store"
+)
+
+func newDeleter(accountID string) *Deleter {
+	records := fakes.NewNest()
+	vespiaryClient := fakes.NewVespiary()
+	vespiaryClient.EnsureAccount(accountID, accountID)
+	return NewDeleter(store.New(records), vespiaryClient, fakes.NewWasp(records), records)
+}
+
+func TestRunCompletes(t *testing.T) {
+	ctx := context.Background()
+	d := newDeleter("account")
+	_, token, err := d.Request(ctx, "account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Run(ctx, "account", "wrong token"); err != ErrInvalidConfirmation {
+		t.Fatalf("expected ErrInvalidConfirmation, got %v", err)
+	}
+	job, err := d.Run(ctx, "account", token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status() != StatusCompleted || job.CompletedAt == nil {
+		t.Fatalf("unexpected deletion status %s", job.Status())
+	}
+}
+
+func TestRunInProgress(t *testing.T) {
+	ctx := context.Background()
+	d := newDeleter("account")
+	_, token, err := d.Request(ctx, "account")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Deletion running in this process.
+	d.running["account"] = struct{}{}
+	if _, err := d.Run(ctx, "account", token); err != ErrInProgress {
+		t.Fatalf("expected ErrInProgress, got %v", err)
+	}
+	if _, _, err := d.Request(ctx, "account"); err != ErrInProgress {
+		t.Fatalf("expected ErrInProgress, got %v", err)
+	}
+	delete(d.running, "account")
+
+	// Deletion running on another server, which saved its progress recently.
+	job, err := d.Load(ctx, "account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	confirmedAt := time.Now()
+	job.ConfirmedAt = &confirmedAt
+	err = d.save(ctx, job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Run(ctx, "account", token); err != ErrInProgress {
+		t.Fatalf("expected ErrInProgress, got %v", err)
+	}
+	if _, _, err := d.Request(ctx, "account"); err != ErrInProgress {
+		t.Fatalf("expected ErrInProgress, got %v", err)
+	}
+
+	// The other server stopped saving its progress: the deletion is resumed.
+	job.UpdatedAt = time.Now().Add(-RunLease)
+	data, err := json.Marshal(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.store.Put(ctx, "account", StoreKind, storeKey, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	job, err = d.Run(ctx, "account", token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status() != StatusCompleted {
+		t.Fatalf("unexpected deletion status %s", job.Status())
+	}
+}
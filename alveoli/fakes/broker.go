@@ -0,0 +1,297 @@
+package fakes
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	"github.com/vx-labs/alveoli/alveoli/topics"
+	"github.com/vx-labs/mqtt-protocol/decoder"
+	"github.com/vx-labs/mqtt-protocol/encoder"
+	packet "github.com/vx-labs/mqtt-protocol/packet"
+	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+)
+
+const (
+	connAckAccepted        = 0
+	connAckBadCredentials  = 4
+	subAckFailure          = 0x80
+	brokerMaxDeliveredQos  = 1
+	brokerDefaultKeepalive = 30
+	brokerConnectTimeout   = 10 * time.Second
+)
+
+// Broker is a minimal MQTT 3.1.1 server, authenticating devices with the fake vespiary and routing their messages
+// through the fake wasp.
+//
+// It supports QoS 0 and 1 delivery, retained messages and last will messages. Messages are not queued for offline
+// sessions.
+type Broker struct {
+	wasp     *Wasp
+	vespiary *Vespiary
+	mtx      sync.Mutex
+	sessions map[*brokerSession]struct{}
+}
+
+// NewBroker returns a broker routing messages through w and authenticating devices with v.
+func NewBroker(w *Wasp, v *Vespiary) *Broker {
+	b := &Broker{wasp: w, vespiary: v, sessions: map[*brokerSession]struct{}{}}
+	w.OnPublish(b.deliver)
+	return b
+}
+
+// Serve accepts MQTT connections on listener until ctx is cancelled.
+func (b *Broker) Serve(ctx context.Context, listener net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go b.serveConn(ctx, conn)
+	}
+}
+
+type brokerSession struct {
+	id         string
+	mountPoint string
+	conn       net.Conn
+	encoder    *encoder.Encoder
+	mtx        sync.Mutex
+	nextID     int32
+	patterns   map[string]int32
+}
+
+func (s *brokerSession) write(p packet.Packet) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.encoder.Encode(s.conn, p)
+}
+
+func (s *brokerSession) prefix() string {
+	return s.mountPoint + "/"
+}
+
+func (b *Broker) deliver(message *packet.Publish) {
+	b.mtx.Lock()
+	sessions := make([]*brokerSession, 0, len(b.sessions))
+	for session := range b.sessions {
+		sessions = append(sessions, session)
+	}
+	b.mtx.Unlock()
+	for _, session := range sessions {
+		session.deliver(message, false)
+	}
+}
+
+// deliver sends message to the session if it is subscribed to its topic.
+func (s *brokerSession) deliver(message *packet.Publish, retained bool) {
+	topic := string(message.Topic)
+	if !strings.HasPrefix(topic, s.prefix()) {
+		return
+	}
+	topic = strings.TrimPrefix(topic, s.prefix())
+	s.mtx.Lock()
+	qos := int32(-1)
+	for pattern, patternQos := range s.patterns {
+		if topics.Match(pattern, topic) && patternQos > qos {
+			qos = patternQos
+		}
+	}
+	if qos < 0 {
+		s.mtx.Unlock()
+		return
+	}
+	if message.Header != nil && message.Header.Qos < qos {
+		qos = message.Header.Qos
+	}
+	var messageID int32
+	if qos > 0 {
+		s.nextID = s.nextID%65535 + 1
+		messageID = s.nextID
+	}
+	s.mtx.Unlock()
+	err := s.write(&packet.Publish{
+		Header:    &packet.Header{Qos: qos, Retain: retained},
+		MessageId: messageID,
+		Topic:     []byte(topic),
+		Payload:   message.Payload,
+	})
+	if err != nil {
+		s.conn.Close()
+	}
+}
+
+func (b *Broker) serveConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	dec := decoder.New()
+	conn.SetReadDeadline(time.Now().Add(brokerConnectTimeout))
+	p, err := dec.Decode(reader)
+	if err != nil {
+		return
+	}
+	connect, ok := p.(*packet.Connect)
+	if !ok {
+		return
+	}
+	session := &brokerSession{conn: conn, encoder: encoder.New(), patterns: map[string]int32{}}
+	var mountPoint string
+	session.id, mountPoint, err = b.vespiary.Authenticate(connect.Username, connect.Password)
+	if err != nil {
+		session.write(&packet.ConnAck{Header: &packet.Header{}, ReturnCode: connAckBadCredentials})
+		return
+	}
+	// Devices of all the tenants are mounted under tenancy.Root, outside of the records alveoli keeps for itself.
+	session.mountPoint = tenancy.Root + "/" + mountPoint
+	err = session.write(&packet.ConnAck{Header: &packet.Header{}, ReturnCode: connAckAccepted})
+	if err != nil {
+		return
+	}
+	var will *packet.Publish
+	if len(connect.WillTopic) > 0 && topics.ValidateName(string(connect.WillTopic)) == nil {
+		will = &packet.Publish{
+			Header:  &packet.Header{Qos: connect.WillQos, Retain: connect.WillRetain},
+			Topic:   []byte(session.prefix() + string(connect.WillTopic)),
+			Payload: connect.WillPayload,
+		}
+	}
+	b.wasp.Connect(&wasp.SessionMetadatas{
+		SessionID:  session.id,
+		ClientID:   string(connect.ClientId),
+		MountPoint: session.mountPoint,
+		LWT:        will,
+	})
+	b.mtx.Lock()
+	b.sessions[session] = struct{}{}
+	b.mtx.Unlock()
+	log.Printf("mqtt session %s connected on %s", session.id, session.mountPoint)
+
+	keepalive := time.Duration(connect.KeepaliveTimer) * time.Second
+	if keepalive == 0 {
+		keepalive = brokerDefaultKeepalive * time.Second
+	}
+	graceful := b.serveSession(ctx, session, reader, dec, keepalive)
+
+	b.mtx.Lock()
+	delete(b.sessions, session)
+	b.mtx.Unlock()
+	b.wasp.Disconnect(session.id)
+	if !graceful && will != nil {
+		b.wasp.publish(ctx, session.id, will)
+	}
+	log.Printf("mqtt session %s disconnected", session.id)
+}
+
+// serveSession handles the packets sent by an authenticated session, and returns true if the session ended with
+// a DISCONNECT packet.
+func (b *Broker) serveSession(ctx context.Context, session *brokerSession, r io.Reader, dec *decoder.Sync, keepalive time.Duration) bool {
+	for {
+		// Clients may stay silent for one and a half keepalive periods.
+		session.conn.SetReadDeadline(time.Now().Add(keepalive * 3 / 2))
+		p, err := dec.Decode(r)
+		if err != nil {
+			return false
+		}
+		switch p := p.(type) {
+		case *packet.Publish:
+			if topics.ValidateName(string(p.Topic)) != nil {
+				return false
+			}
+			header := p.Header
+			if header == nil {
+				header = &packet.Header{}
+			}
+			qos := header.Qos
+			message := &packet.Publish{
+				Header:  &packet.Header{Qos: qos, Retain: header.Retain},
+				Topic:   []byte(session.prefix() + string(p.Topic)),
+				Payload: p.Payload,
+			}
+			if b.wasp.publish(ctx, session.id, message) != nil {
+				return false
+			}
+			switch qos {
+			case 1:
+				err = session.write(&packet.PubAck{Header: &packet.Header{}, MessageId: p.MessageId})
+			case 2:
+				err = session.write(&packet.PubRec{Header: &packet.Header{}, MessageId: p.MessageId})
+			}
+		case *packet.PubRel:
+			err = session.write(&packet.PubComp{Header: &packet.Header{}, MessageId: p.MessageId})
+		case *packet.PubAck:
+		case *packet.Subscribe:
+			granted := make([]int32, len(p.Topic))
+			for idx, pattern := range p.Topic {
+				if topics.ValidateFilter(string(pattern)) != nil {
+					granted[idx] = subAckFailure
+					continue
+				}
+				qos := p.Qos[idx]
+				if qos > brokerMaxDeliveredQos {
+					qos = brokerMaxDeliveredQos
+				}
+				granted[idx] = qos
+				session.mtx.Lock()
+				session.patterns[string(pattern)] = qos
+				session.mtx.Unlock()
+				b.wasp.CreateSubscription(ctx, &wasp.CreateSubscriptionRequest{
+					SessionID: session.id,
+					Pattern:   []byte(session.prefix() + string(pattern)),
+					QoS:       qos,
+				})
+			}
+			err = session.write(&packet.SubAck{Header: &packet.Header{}, MessageId: p.MessageId, Qos: granted})
+			if err == nil {
+				b.sendRetained(ctx, session, p.Topic)
+			}
+		case *packet.Unsubscribe:
+			for _, pattern := range p.Topic {
+				session.mtx.Lock()
+				delete(session.patterns, string(pattern))
+				session.mtx.Unlock()
+				b.wasp.DeleteSubscription(ctx, &wasp.DeleteSubscriptionRequest{
+					SessionID: session.id,
+					Pattern:   []byte(session.prefix() + string(pattern)),
+				})
+			}
+			err = session.write(&packet.UnsubAck{Header: &packet.Header{}, MessageId: p.MessageId})
+		case *packet.PingReq:
+			err = session.write(&packet.PingResp{Header: &packet.Header{}})
+		case *packet.Disconnect:
+			return true
+		default:
+			return false
+		}
+		if err != nil {
+			return false
+		}
+	}
+}
+
+// sendRetained sends the retained messages matching the new subscriptions of a session.
+func (b *Broker) sendRetained(ctx context.Context, session *brokerSession, patterns [][]byte) {
+	for _, pattern := range patterns {
+		out, err := b.wasp.ListRetainedMessages(ctx, &wasp.ListRetainedMessagesRequest{
+			Pattern: []byte(session.prefix() + string(pattern)),
+		})
+		if err != nil {
+			continue
+		}
+		for _, message := range out.RetainedMessages {
+			session.deliver(message.Publish, true)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package fakes
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/vx-labs/alveoli/alveoli/topics"
+	nest "github.com/vx-labs/nest/nest/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// streamBatchSize is the maximum number of records sent in a single stream message.
+const streamBatchSize = 100
+
+// Nest is an in-memory nest.MessagesClient.
+type Nest struct {
+	mtx      sync.Mutex
+	records  []*nest.Record
+	watchers map[*watcher]struct{}
+}
+
+var _ nest.MessagesClient = &Nest{}
+
+// NewNest returns an empty nest.
+func NewNest() *Nest {
+	return &Nest{watchers: map[*watcher]struct{}{}}
+}
+
+type watcher struct {
+	ctx     context.Context
+	match   func(record *nest.Record) bool
+	updates chan []*nest.Record
+}
+
+func matchAny(patterns [][]byte) func(record *nest.Record) bool {
+	return func(record *nest.Record) bool {
+		for _, pattern := range patterns {
+			if topics.Match(string(pattern), string(record.Topic)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func guessContentType(payload []byte) string {
+	switch {
+	case json.Valid(payload):
+		return "application/json"
+	case utf8.Valid(payload):
+		return "text/plain"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+func (n *Nest) PutRecords(ctx context.Context, in *nest.PutRecordsRequest, opts ...grpc.CallOption) (*nest.PutRecordsResponse, error) {
+	n.mtx.Lock()
+	n.records = append(n.records, in.Records...)
+	watchers := make([]*watcher, 0, len(n.watchers))
+	for w := range n.watchers {
+		watchers = append(watchers, w)
+	}
+	n.mtx.Unlock()
+
+	for _, w := range watchers {
+		matched := []*nest.Record{}
+		for _, record := range in.Records {
+			if w.match(record) {
+				matched = append(matched, record)
+			}
+		}
+		if len(matched) == 0 {
+			continue
+		}
+		select {
+		case w.updates <- matched:
+		case <-w.ctx.Done():
+		}
+	}
+	return &nest.PutRecordsResponse{}, nil
+}
+
+func (n *Nest) ListTopics(ctx context.Context, in *nest.ListTopicsRequest, opts ...grpc.CallOption) (*nest.ListTopicsResponse, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	index := map[string]*nest.TopicMetadata{}
+	out := []*nest.TopicMetadata{}
+	for _, record := range n.records {
+		if !topics.Match(string(in.Pattern), string(record.Topic)) {
+			continue
+		}
+		metadata, ok := index[string(record.Topic)]
+		if !ok {
+			metadata = &nest.TopicMetadata{Name: record.Topic}
+			index[string(record.Topic)] = metadata
+			out = append(out, metadata)
+		}
+		metadata.MessageCount++
+		metadata.SizeInBytes += uint64(len(record.Payload))
+		metadata.LastRecord = record
+		metadata.GuessedContentType = guessContentType(record.Payload)
+	}
+	sort.Slice(out, func(i, j int) bool { return string(out[i].Name) < string(out[j].Name) })
+	return &nest.ListTopicsResponse{TopicMetadatas: out}, nil
+}
+
+// lookupTimestamp returns the offset of the first record whose timestamp is not before ts.
+func (n *Nest) lookupTimestamp(ts int64) int64 {
+	for offset, record := range n.records {
+		if record.Timestamp >= ts {
+			return int64(offset)
+		}
+	}
+	return int64(len(n.records))
+}
+
+// open returns a stream of the stored records matching patterns, followed by new records when watch is true.
+// Like nest, records are sent in the order they were stored, and fromTimestamp is resolved to the offset of the first
+// record not older than it: records stored after this offset are sent even if their timestamp is older.
+func (n *Nest) open(ctx context.Context, patterns [][]byte, fromOffset, fromTimestamp int64, watch bool) *recordStream {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	match := matchAny(patterns)
+	stream := &recordStream{ctx: ctx}
+	batch := []*nest.Record{}
+	if fromTimestamp > 0 {
+		if offset := n.lookupTimestamp(fromTimestamp); offset > fromOffset {
+			fromOffset = offset
+		}
+	}
+	for offset, record := range n.records {
+		if int64(offset) < fromOffset || !match(record) {
+			continue
+		}
+		batch = append(batch, record)
+		if len(batch) == streamBatchSize {
+			stream.backlog = append(stream.backlog, batch)
+			batch = []*nest.Record{}
+		}
+	}
+	if len(batch) > 0 {
+		stream.backlog = append(stream.backlog, batch)
+	}
+	if watch {
+		w := &watcher{ctx: ctx, match: match, updates: make(chan []*nest.Record, streamBatchSize)}
+		n.watchers[w] = struct{}{}
+		stream.updates = w.updates
+		go func() {
+			<-ctx.Done()
+			n.mtx.Lock()
+			delete(n.watchers, w)
+			n.mtx.Unlock()
+		}()
+	}
+	return stream
+}
+
+func (n *Nest) GetRecords(ctx context.Context, in *nest.GetRecordsRequest, opts ...grpc.CallOption) (nest.Messages_GetRecordsClient, error) {
+	return &getRecordsStream{n.open(ctx, in.Patterns, in.FromOffset, in.FromTimestamp, in.Watch)}, nil
+}
+
+func (n *Nest) GetTopics(ctx context.Context, in *nest.GetTopicsRequest, opts ...grpc.CallOption) (nest.Messages_GetTopicsClient, error) {
+	return &getTopicsStream{n.open(ctx, [][]byte{in.Pattern}, in.FromOffset, in.FromTimestamp, in.Watch)}, nil
+}
+
+// recordStream sends its backlog, then the updates of its watcher if any.
+type recordStream struct {
+	ctx     context.Context
+	backlog [][]*nest.Record
+	updates chan []*nest.Record
+}
+
+func (s *recordStream) next() ([]*nest.Record, error) {
+	if len(s.backlog) > 0 {
+		batch := s.backlog[0]
+		s.backlog = s.backlog[1:]
+		return batch, nil
+	}
+	if s.updates == nil {
+		return nil, io.EOF
+	}
+	select {
+	case batch := <-s.updates:
+		return batch, nil
+	case <-s.ctx.Done():
+		return nil, status.Error(codes.Canceled, s.ctx.Err().Error())
+	}
+}
+
+func (s *recordStream) Header() (metadata.MD, error) { return metadata.MD{}, nil }
+func (s *recordStream) Trailer() metadata.MD         { return metadata.MD{} }
+func (s *recordStream) CloseSend() error             { return nil }
+func (s *recordStream) Context() context.Context     { return s.ctx }
+func (s *recordStream) SendMsg(m interface{}) error  { return nil }
+func (s *recordStream) RecvMsg(m interface{}) error {
+	return status.Error(codes.Unimplemented, "RecvMsg is not supported by fake streams")
+}
+
+type getRecordsStream struct {
+	*recordStream
+}
+
+func (s *getRecordsStream) Recv() (*nest.GetRecordsResponse, error) {
+	records, err := s.next()
+	if err != nil {
+		return nil, err
+	}
+	return &nest.GetRecordsResponse{Records: records}, nil
+}
+
+type getTopicsStream struct {
+	*recordStream
+}
+
+func (s *getTopicsStream) Recv() (*nest.GetTopicsResponse, error) {
+	records, err := s.next()
+	if err != nil {
+		return nil, err
+	}
+	return &nest.GetTopicsResponse{Records: records}, nil
+}
@@ -0,0 +1,475 @@
+// Package fakes provides in-memory implementations of the vespiary, wasp and nest clients.
+//
+// They are meant to run alveoli without its backing services, in tests and on development workstations.
+// They implement the behaviour alveoli relies on, and are not a reference for the real services: known differences
+// with them are documented next to the methods.
+package fakes
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Vespiary is an in-memory vespiary.VespiaryClient.
+type Vespiary struct {
+	mtx          sync.Mutex
+	accounts     map[string]*vespiary.Account
+	applications map[string]*vespiary.Application
+	profiles     map[string]*vespiary.ApplicationProfile
+	devices      map[string]map[string]*vespiary.Device
+}
+
+var _ vespiary.VespiaryClient = &Vespiary{}
+
+// NewVespiary returns an empty vespiary.
+func NewVespiary() *Vespiary {
+	return &Vespiary{
+		accounts:     map[string]*vespiary.Account{},
+		applications: map[string]*vespiary.Application{},
+		profiles:     map[string]*vespiary.ApplicationProfile{},
+		devices:      map[string]map[string]*vespiary.Device{},
+	}
+}
+
+var errInvalidCredentials = status.Error(codes.InvalidArgument, "invalid username or password")
+
+func notFound(kind string) error {
+	return status.Errorf(codes.NotFound, "%s not found", kind)
+}
+
+// EnsureAccount creates the account with the given ID, unless it already exists.
+func (v *Vespiary) EnsureAccount(id, name string) *vespiary.Account {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	if account, ok := v.accounts[id]; ok {
+		return account
+	}
+	account := &vespiary.Account{ID: id, Name: name, CreatedAt: time.Now().UnixNano()}
+	v.accounts[id] = account
+	return account
+}
+
+// Authenticate checks MQTT credentials like vespiary does for application profiles: username is made of
+// the account, application and profile names separated by slashes.
+// It returns the ID and the mount point of the session, formatted like vespiary does: "<applicationName>/
+// <applicationProfileName>/<uuid>" and "<accountID>/<applicationID>".
+//
+// Unlike vespiary, which dereferences a nil profile when the profile name is unknown, unknown profiles are
+// reported as invalid credentials. Like vespiary, disabled profiles are accepted.
+func (v *Vespiary) Authenticate(username, password []byte) (string, string, error) {
+	tokens := strings.SplitN(string(username), "/", 3)
+	if len(tokens) != 3 {
+		return "", "", errInvalidCredentials
+	}
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	for _, account := range v.accounts {
+		if account.Name != tokens[0] {
+			continue
+		}
+		for _, application := range v.applicationsOf(account.ID) {
+			if application.Name != tokens[1] {
+				continue
+			}
+			for _, profile := range v.profilesOf(account.ID, application.ID) {
+				if profile.Name != tokens[2] {
+					continue
+				}
+				if !bytes.Equal(fingerprint(password, profile.PasswordSalt), profile.PasswordFingerprint) {
+					return "", "", errInvalidCredentials
+				}
+				return tenancy.SessionID(application.Name, profile.Name, uuid.New().String()), account.ID + "/" + application.ID, nil
+			}
+		}
+	}
+	return "", "", errInvalidCredentials
+}
+
+func fingerprint(password, salt []byte) []byte {
+	sum := sha256.Sum256(append(append([]byte{}, password...), salt...))
+	return sum[:]
+}
+
+func (v *Vespiary) applicationsOf(accountID string) []*vespiary.Application {
+	out := []*vespiary.Application{}
+	for _, application := range v.applications {
+		if accountID == "" || application.AccountID == accountID {
+			out = append(out, application)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+func (v *Vespiary) profilesOf(accountID, applicationID string) []*vespiary.ApplicationProfile {
+	out := []*vespiary.ApplicationProfile{}
+	for _, profile := range v.profiles {
+		if (accountID == "" || profile.AccountID == accountID) && (applicationID == "" || profile.ApplicationID == applicationID) {
+			out = append(out, profile)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// CreateApplication creates an application. Unlike vespiary, it rejects duplicate names inside an account: vespiary
+// indexes applications by name, and a duplicate name breaks the lookups of the first application.
+func (v *Vespiary) CreateApplication(ctx context.Context, in *vespiary.CreateApplicationRequest, opts ...grpc.CallOption) (*vespiary.CreateApplicationResponse, error) {
+	if in.AccountID == "" || in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "accountID and name are required")
+	}
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	for _, application := range v.applicationsOf(in.AccountID) {
+		if application.Name == in.Name {
+			return nil, status.Error(codes.AlreadyExists, "application already exists")
+		}
+	}
+	id := uuid.New().String()
+	v.applications[id] = &vespiary.Application{ID: id, AccountID: in.AccountID, Name: in.Name}
+	return &vespiary.CreateApplicationResponse{ID: id}, nil
+}
+
+func (v *Vespiary) DeleteApplication(ctx context.Context, in *vespiary.DeleteApplicationRequest, opts ...grpc.CallOption) (*vespiary.DeleteApplicationResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	if _, ok := v.applications[in.ID]; !ok {
+		return nil, notFound("application")
+	}
+	delete(v.applications, in.ID)
+	return &vespiary.DeleteApplicationResponse{}, nil
+}
+
+func (v *Vespiary) DeleteApplicationByAccountID(ctx context.Context, in *vespiary.DeleteApplicationByAccountIDRequest, opts ...grpc.CallOption) (*vespiary.DeleteApplicationByAccountIDResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	application, ok := v.applications[in.ID]
+	if !ok || application.AccountID != in.AccountID {
+		return nil, notFound("application")
+	}
+	delete(v.applications, in.ID)
+	return &vespiary.DeleteApplicationByAccountIDResponse{}, nil
+}
+
+func (v *Vespiary) ListApplications(ctx context.Context, in *vespiary.ListApplicationsRequest, opts ...grpc.CallOption) (*vespiary.ListApplicationsResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	return &vespiary.ListApplicationsResponse{Applications: v.applicationsOf("")}, nil
+}
+
+func (v *Vespiary) ListApplicationsByAccountID(ctx context.Context, in *vespiary.ListApplicationsByAccountIDRequest, opts ...grpc.CallOption) (*vespiary.ListApplicationsByAccountIDResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	return &vespiary.ListApplicationsByAccountIDResponse{Applications: v.applicationsOf(in.AccountID)}, nil
+}
+
+// CreateApplicationProfile creates an application profile, rejecting empty names and passwords shorter than
+// 8 characters like vespiary does. Unlike vespiary, it also rejects duplicate names inside an application: vespiary
+// indexes profiles by name, and a duplicate name breaks the authentication of the devices using the first profile.
+func (v *Vespiary) CreateApplicationProfile(ctx context.Context, in *vespiary.CreateApplicationProfileRequest, opts ...grpc.CallOption) (*vespiary.CreateApplicationProfileResponse, error) {
+	if in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "application profile must have a name")
+	}
+	if len(in.Password) < 8 {
+		return nil, status.Error(codes.InvalidArgument, "password too short")
+	}
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	application, ok := v.applications[in.ApplicationID]
+	if !ok || application.AccountID != in.AccountID {
+		return nil, notFound("application")
+	}
+	for _, profile := range v.profilesOf(in.AccountID, in.ApplicationID) {
+		if profile.Name == in.Name {
+			return nil, status.Error(codes.AlreadyExists, "application profile already exists")
+		}
+	}
+	salt := make([]byte, 16)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	id := uuid.New().String()
+	v.profiles[id] = &vespiary.ApplicationProfile{
+		ID:                  id,
+		AccountID:           in.AccountID,
+		ApplicationID:       in.ApplicationID,
+		Name:                in.Name,
+		Enabled:             true,
+		PasswordFingerprint: fingerprint([]byte(in.Password), salt),
+		PasswordSalt:        salt,
+	}
+	return &vespiary.CreateApplicationProfileResponse{ID: id}, nil
+}
+
+func (v *Vespiary) GetApplicationProfileByAccountID(ctx context.Context, in *vespiary.GetApplicationProfileByAccountIDRequest, opts ...grpc.CallOption) (*vespiary.GetApplicationProfileByAccountIDResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	profile, ok := v.profiles[in.ID]
+	if !ok || profile.AccountID != in.AccountID {
+		return nil, notFound("application profile")
+	}
+	return &vespiary.GetApplicationProfileByAccountIDResponse{ApplicationProfile: profile}, nil
+}
+
+func (v *Vespiary) GetApplication(ctx context.Context, in *vespiary.GetApplicationRequest, opts ...grpc.CallOption) (*vespiary.GetApplicationResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	application, ok := v.applications[in.Id]
+	if !ok {
+		return nil, notFound("application")
+	}
+	return &vespiary.GetApplicationResponse{Application: application}, nil
+}
+
+func (v *Vespiary) GetApplicationByAccountID(ctx context.Context, in *vespiary.GetApplicationByAccountIDRequest, opts ...grpc.CallOption) (*vespiary.GetApplicationByAccountIDResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	application, ok := v.applications[in.Id]
+	if !ok || application.AccountID != in.AccountID {
+		return nil, notFound("application")
+	}
+	return &vespiary.GetApplicationByAccountIDResponse{Application: application}, nil
+}
+
+func (v *Vespiary) GetApplicationByName(ctx context.Context, in *vespiary.GetApplicationByNameRequest, opts ...grpc.CallOption) (*vespiary.GetApplicationByNameResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	for _, application := range v.applicationsOf(in.AccountID) {
+		if application.Name == in.Name {
+			return &vespiary.GetApplicationByNameResponse{Application: application}, nil
+		}
+	}
+	return nil, notFound("application")
+}
+
+func (v *Vespiary) ListApplicationProfiles(ctx context.Context, in *vespiary.ListApplicationProfilesRequest, opts ...grpc.CallOption) (*vespiary.ListApplicationProfilesResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	return &vespiary.ListApplicationProfilesResponse{ApplicationProfiles: v.profilesOf("", "")}, nil
+}
+
+func (v *Vespiary) ListApplicationProfilesByAccountID(ctx context.Context, in *vespiary.ListApplicationProfilesByAccountIDRequest, opts ...grpc.CallOption) (*vespiary.ListApplicationProfilesByAccountIDResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	return &vespiary.ListApplicationProfilesByAccountIDResponse{ApplicationProfiles: v.profilesOf(in.AccountID, "")}, nil
+}
+
+func (v *Vespiary) ListApplicationProfilesByApplication(ctx context.Context, in *vespiary.ListApplicationProfilesByApplicationRequest, opts ...grpc.CallOption) (*vespiary.ListApplicationProfilesByApplicationResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	return &vespiary.ListApplicationProfilesByApplicationResponse{ApplicationProfiles: v.profilesOf(in.AccountID, in.ApplicationID)}, nil
+}
+
+func (v *Vespiary) DeleteApplicationProfile(ctx context.Context, in *vespiary.DeleteApplicationProfileRequest, opts ...grpc.CallOption) (*vespiary.DeleteApplicationProfileResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	if _, ok := v.profiles[in.ID]; !ok {
+		return nil, notFound("application profile")
+	}
+	delete(v.profiles, in.ID)
+	return &vespiary.DeleteApplicationProfileResponse{}, nil
+}
+
+func (v *Vespiary) DeleteApplicationProfileByAccountID(ctx context.Context, in *vespiary.DeleteApplicationProfileByAccountIDRequest, opts ...grpc.CallOption) (*vespiary.DeleteApplicationProfileByAccountIDResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	profile, ok := v.profiles[in.ID]
+	if !ok || profile.AccountID != in.AccountID {
+		return nil, notFound("application profile")
+	}
+	delete(v.profiles, in.ID)
+	return &vespiary.DeleteApplicationProfileByAccountIDResponse{}, nil
+}
+
+func (v *Vespiary) CreateAccount(ctx context.Context, in *vespiary.CreateAccountRequest, opts ...grpc.CallOption) (*vespiary.CreateAccountResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	id := uuid.New().String()
+	v.accounts[id] = &vespiary.Account{
+		ID:              id,
+		Name:            in.Name,
+		Principals:      append([]string{}, in.Principals...),
+		DeviceUsernames: append([]string{}, in.DeviceUsernames...),
+		CreatedAt:       time.Now().UnixNano(),
+	}
+	return &vespiary.CreateAccountResponse{ID: id}, nil
+}
+
+func (v *Vespiary) DeleteAccount(ctx context.Context, in *vespiary.DeleteAccountRequest, opts ...grpc.CallOption) (*vespiary.DeleteAccountResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	if _, ok := v.accounts[in.ID]; !ok {
+		return nil, notFound("account")
+	}
+	delete(v.accounts, in.ID)
+	return &vespiary.DeleteAccountResponse{}, nil
+}
+
+func (v *Vespiary) ListAccounts(ctx context.Context, in *vespiary.ListAccountsRequest, opts ...grpc.CallOption) (*vespiary.ListAccountsResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	out := make([]*vespiary.Account, 0, len(v.accounts))
+	for _, account := range v.accounts {
+		out = append(out, account)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return &vespiary.ListAccountsResponse{Accounts: out}, nil
+}
+
+func (v *Vespiary) GetAccountByPrincipal(ctx context.Context, in *vespiary.GetAccountByPrincipalRequest, opts ...grpc.CallOption) (*vespiary.GetAccountByPrincipalResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	for _, account := range v.accounts {
+		for _, principal := range account.Principals {
+			if principal == in.Principal {
+				return &vespiary.GetAccountByPrincipalResponse{Account: account}, nil
+			}
+		}
+	}
+	return nil, notFound("account")
+}
+
+func (v *Vespiary) GetAccountByDeviceUsername(ctx context.Context, in *vespiary.GetAccountByDeviceUsernameRequest, opts ...grpc.CallOption) (*vespiary.GetAccountByDeviceUsernameResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	for _, account := range v.accounts {
+		for _, username := range account.DeviceUsernames {
+			if username == in.Username {
+				return &vespiary.GetAccountByDeviceUsernameResponse{Account: account}, nil
+			}
+		}
+	}
+	return nil, notFound("account")
+}
+
+func (v *Vespiary) AddAccountDeviceUsername(ctx context.Context, in *vespiary.AddAccountDeviceUsernameRequest, opts ...grpc.CallOption) (*vespiary.AddAccountDeviceUsernameResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	account, ok := v.accounts[in.ID]
+	if !ok {
+		return nil, notFound("account")
+	}
+	account.DeviceUsernames = append(account.DeviceUsernames, in.Username)
+	return &vespiary.AddAccountDeviceUsernameResponse{}, nil
+}
+
+func (v *Vespiary) RemoveAccountDeviceUsername(ctx context.Context, in *vespiary.RemoveAccountDeviceUsernameRequest, opts ...grpc.CallOption) (*vespiary.RemoveAccountDeviceUsernameResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	account, ok := v.accounts[in.ID]
+	if !ok {
+		return nil, notFound("account")
+	}
+	usernames := account.DeviceUsernames[:0]
+	for _, username := range account.DeviceUsernames {
+		if username != in.Username {
+			usernames = append(usernames, username)
+		}
+	}
+	account.DeviceUsernames = usernames
+	return &vespiary.RemoveAccountDeviceUsernameResponse{}, nil
+}
+
+func (v *Vespiary) CreateDevice(ctx context.Context, in *vespiary.CreateDeviceRequest, opts ...grpc.CallOption) (*vespiary.CreateDeviceResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	if v.devices[in.Owner] == nil {
+		v.devices[in.Owner] = map[string]*vespiary.Device{}
+	}
+	id := uuid.New().String()
+	v.devices[in.Owner][id] = &vespiary.Device{
+		Owner:     in.Owner,
+		ID:        id,
+		Name:      in.Name,
+		Active:    in.Active,
+		CreatedAt: time.Now().UnixNano(),
+		Password:  in.Password,
+	}
+	return &vespiary.CreateDeviceResponse{ID: id}, nil
+}
+
+func (v *Vespiary) device(owner, id string) (*vespiary.Device, error) {
+	device, ok := v.devices[owner][id]
+	if !ok {
+		return nil, notFound("device")
+	}
+	return device, nil
+}
+
+func (v *Vespiary) DeleteDevice(ctx context.Context, in *vespiary.DeleteDeviceRequest, opts ...grpc.CallOption) (*vespiary.DeleteDeviceResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	if _, err := v.device(in.Owner, in.ID); err != nil {
+		return nil, err
+	}
+	delete(v.devices[in.Owner], in.ID)
+	return &vespiary.DeleteDeviceResponse{ID: in.ID}, nil
+}
+
+func (v *Vespiary) ListDevices(ctx context.Context, in *vespiary.ListDevicesRequest, opts ...grpc.CallOption) (*vespiary.ListDevicesResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	out := make([]*vespiary.Device, 0, len(v.devices[in.Owner]))
+	for _, device := range v.devices[in.Owner] {
+		out = append(out, device)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return &vespiary.ListDevicesResponse{Devices: out}, nil
+}
+
+func (v *Vespiary) GetDevice(ctx context.Context, in *vespiary.GetDeviceRequest, opts ...grpc.CallOption) (*vespiary.GetDeviceResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	device, err := v.device(in.Owner, in.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &vespiary.GetDeviceResponse{Device: device}, nil
+}
+
+func (v *Vespiary) EnableDevice(ctx context.Context, in *vespiary.EnableDeviceRequest, opts ...grpc.CallOption) (*vespiary.EnableDeviceResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	device, err := v.device(in.Owner, in.ID)
+	if err != nil {
+		return nil, err
+	}
+	device.Active = true
+	return &vespiary.EnableDeviceResponse{}, nil
+}
+
+func (v *Vespiary) DisableDevice(ctx context.Context, in *vespiary.DisableDeviceRequest, opts ...grpc.CallOption) (*vespiary.DisableDeviceResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	device, err := v.device(in.Owner, in.ID)
+	if err != nil {
+		return nil, err
+	}
+	device.Active = false
+	return &vespiary.DisableDeviceResponse{}, nil
+}
+
+func (v *Vespiary) ChangeDevicePassword(ctx context.Context, in *vespiary.ChangeDevicePasswordRequest, opts ...grpc.CallOption) (*vespiary.ChangeDevicePasswordResponse, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	device, err := v.device(in.Owner, in.ID)
+	if err != nil {
+		return nil, err
+	}
+	device.Password = in.NewPassword
+	return &vespiary.ChangeDevicePasswordResponse{}, nil
+}
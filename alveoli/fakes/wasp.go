@@ -0,0 +1,189 @@
+package fakes
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/topics"
+	packet "github.com/vx-labs/mqtt-protocol/packet"
+	nest "github.com/vx-labs/nest/nest/api"
+	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Wasp is an in-memory wasp.MQTTClient.
+//
+// Messages published through it are recorded in the provided nest, like the wasp message log does.
+type Wasp struct {
+	mtx           sync.Mutex
+	nest          *Nest
+	sessions      map[string]*wasp.SessionMetadatas
+	subscriptions []*wasp.Subscription
+	retained      map[string]*wasp.RetainedMessage
+	handlers      []func(message *packet.Publish)
+}
+
+var _ wasp.MQTTClient = &Wasp{}
+
+// NewWasp returns a broker without sessions, recording published messages in records when it is not nil.
+func NewWasp(records *Nest) *Wasp {
+	return &Wasp{
+		nest:     records,
+		sessions: map[string]*wasp.SessionMetadatas{},
+		retained: map[string]*wasp.RetainedMessage{},
+	}
+}
+
+// OnPublish calls handler with each message published on the broker.
+func (w *Wasp) OnPublish(handler func(message *packet.Publish)) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	w.handlers = append(w.handlers, handler)
+}
+
+// Connect registers a session, as if a device connected to the broker.
+func (w *Wasp) Connect(session *wasp.SessionMetadatas) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	if session.ConnectedAt == 0 {
+		session.ConnectedAt = time.Now().UnixNano()
+	}
+	w.sessions[session.SessionID] = session
+}
+
+// Disconnect removes a session and its subscriptions.
+func (w *Wasp) Disconnect(sessionID string) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	delete(w.sessions, sessionID)
+	subscriptions := w.subscriptions[:0]
+	for _, subscription := range w.subscriptions {
+		if subscription.SessionID != sessionID {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	w.subscriptions = subscriptions
+}
+
+func (w *Wasp) ListSubscriptions(ctx context.Context, in *wasp.ListSubscriptionsRequest, opts ...grpc.CallOption) (*wasp.ListSubscriptionsResponse, error) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	return &wasp.ListSubscriptionsResponse{Subscriptions: append([]*wasp.Subscription{}, w.subscriptions...)}, nil
+}
+
+func (w *Wasp) DeleteSubscription(ctx context.Context, in *wasp.DeleteSubscriptionRequest, opts ...grpc.CallOption) (*wasp.DeleteSubscriptionResponse, error) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	for idx, subscription := range w.subscriptions {
+		if subscription.SessionID == in.SessionID && string(subscription.Pattern) == string(in.Pattern) {
+			w.subscriptions = append(w.subscriptions[:idx], w.subscriptions[idx+1:]...)
+			return &wasp.DeleteSubscriptionResponse{}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "subscription not found")
+}
+
+func (w *Wasp) CreateSubscription(ctx context.Context, in *wasp.CreateSubscriptionRequest, opts ...grpc.CallOption) (*wasp.CreateSubscriptionResponse, error) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	w.subscriptions = append(w.subscriptions, &wasp.Subscription{
+		SessionID: in.SessionID,
+		Peer:      in.Peer,
+		Pattern:   in.Pattern,
+		QoS:       in.QoS,
+		LastAdded: time.Now().UnixNano(),
+	})
+	return &wasp.CreateSubscriptionResponse{}, nil
+}
+
+// publish distributes message, and records it in nest as sent by sender.
+func (w *Wasp) publish(ctx context.Context, sender string, message *packet.Publish) error {
+	if message == nil || len(message.Topic) == 0 {
+		return status.Error(codes.InvalidArgument, "message topic is required")
+	}
+	now := time.Now().UnixNano()
+	retain := message.Header != nil && message.Header.Retain
+	w.mtx.Lock()
+	if retain {
+		if len(message.Payload) == 0 {
+			delete(w.retained, string(message.Topic))
+		} else {
+			w.retained[string(message.Topic)] = &wasp.RetainedMessage{Publish: message, LastAdded: now}
+		}
+	}
+	handlers := append([]func(message *packet.Publish){}, w.handlers...)
+	w.mtx.Unlock()
+	for _, handler := range handlers {
+		handler(message)
+	}
+	if w.nest == nil {
+		return nil
+	}
+	_, err := w.nest.PutRecords(ctx, &nest.PutRecordsRequest{
+		Records: []*nest.Record{
+			{Timestamp: now, Topic: message.Topic, Payload: message.Payload, Retained: retain, Sender: sender},
+		},
+	})
+	return err
+}
+
+func (w *Wasp) DistributeMessage(ctx context.Context, in *wasp.DistributeMessageRequest, opts ...grpc.CallOption) (*wasp.DistributeMessageResponse, error) {
+	err := w.publish(ctx, "", in.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &wasp.DistributeMessageResponse{}, nil
+}
+
+func (w *Wasp) ScheduleMessage(ctx context.Context, in *wasp.ScheduleMessageRequest, opts ...grpc.CallOption) (*wasp.ScheduleMessageResponse, error) {
+	err := w.publish(ctx, "", in.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &wasp.ScheduleMessageResponse{}, nil
+}
+
+func (w *Wasp) ListSessionMetadatas(ctx context.Context, in *wasp.ListSessionMetadatasRequest, opts ...grpc.CallOption) (*wasp.ListSessionMetadatasResponse, error) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	out := make([]*wasp.SessionMetadatas, 0, len(w.sessions))
+	for _, session := range w.sessions {
+		out = append(out, session)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].SessionID < out[j].SessionID })
+	return &wasp.ListSessionMetadatasResponse{SessionMetadatasList: out}, nil
+}
+
+func (w *Wasp) ListRetainedMessages(ctx context.Context, in *wasp.ListRetainedMessagesRequest, opts ...grpc.CallOption) (*wasp.ListRetainedMessagesResponse, error) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	out := []*wasp.RetainedMessage{}
+	for topic, message := range w.retained {
+		if topics.Match(string(in.Pattern), topic) {
+			out = append(out, message)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return string(out[i].Publish.Topic) < string(out[j].Publish.Topic) })
+	return &wasp.ListRetainedMessagesResponse{RetainedMessages: out}, nil
+}
+
+func (w *Wasp) DeleteRetainedMessage(ctx context.Context, in *wasp.DeleteRetainedMessageRequest, opts ...grpc.CallOption) (*wasp.DeleteRetainedMessageResponse, error) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	delete(w.retained, string(in.Topic))
+	return &wasp.DeleteRetainedMessageResponse{}, nil
+}
+
+func (w *Wasp) ListClusterMembers(ctx context.Context, in *wasp.ListClusterMembersRequest, opts ...grpc.CallOption) (*wasp.ListClusterMembersResponse, error) {
+	return &wasp.ListClusterMembersResponse{
+		ClusterMembers: []*wasp.ClusterMember{{ID: 1, Address: "localhost", HealthState: "healthy", Version: "fake"}},
+	}, nil
+}
+
+func (w *Wasp) JoinCluster(ctx context.Context, in *wasp.JoinClusterRequest, opts ...grpc.CallOption) (*wasp.JoinClusterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "the fake broker cannot join a cluster")
+}
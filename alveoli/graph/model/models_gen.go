@@ -6,15 +6,38 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
+	"github.com/vx-labs/alveoli/alveoli/certificates"
+	"github.com/vx-labs/alveoli/alveoli/search"
+	api1 "github.com/vx-labs/nest/nest/api"
 	"github.com/vx-labs/vespiary/vespiary/api"
-	api1 "github.com/vx-labs/wasp/v4/wasp/api"
+	api2 "github.com/vx-labs/wasp/v4/wasp/api"
 )
 
 type AuditEventPayload interface {
 	IsAuditEventPayload()
 }
 
+type AccountDeletion struct {
+	RequestedAt time.Time              `json:"requestedAt"`
+	ExpiresAt   time.Time              `json:"expiresAt"`
+	ConfirmedAt *time.Time             `json:"confirmedAt"`
+	FinishedAt  *time.Time             `json:"finishedAt"`
+	CompletedAt *time.Time             `json:"completedAt"`
+	Completed   bool                   `json:"completed"`
+	Status      AccountDeletionStatus  `json:"status"`
+	Steps       []*AccountDeletionStep `json:"steps"`
+}
+
+type AccountDeletionStep struct {
+	Name      AccountDeletionStepName  `json:"name"`
+	State     AccountDeletionStepState `json:"state"`
+	Processed int                      `json:"processed"`
+	Remaining int                      `json:"remaining"`
+	Message   *string                  `json:"message"`
+}
+
 type ApplicationCreatedEvent struct {
 	Application *api.Application `json:"application"`
 }
@@ -39,11 +62,32 @@ type ApplicationProfileDeletedEvent struct {
 
 func (ApplicationProfileDeletedEvent) IsAuditEventPayload() {}
 
+type ApplyConfigurationInput struct {
+	Document string `json:"document"`
+	DryRun   *bool  `json:"dryRun"`
+	Prune    *bool  `json:"prune"`
+}
+
+type ApplyConfigurationOutput struct {
+	Changes []*ConfigurationChange `json:"changes"`
+	DryRun  bool                   `json:"dryRun"`
+	Success bool                   `json:"success"`
+}
+
 type AuditEvent struct {
 	Type    AuditEventType    `json:"type"`
 	Payload AuditEventPayload `json:"payload"`
 }
 
+type ConfigurationChange struct {
+	Kind        ConfigurationResourceKind `json:"kind"`
+	Action      ConfigurationChangeAction `json:"action"`
+	Application string                    `json:"application"`
+	Name        string                    `json:"name"`
+	ID          *string                   `json:"id"`
+	Reason      *string                   `json:"reason"`
+}
+
 type CreateApplicationOutput struct {
 	Application *api.Application `json:"application"`
 	Success     bool             `json:"success"`
@@ -51,11 +95,50 @@ type CreateApplicationOutput struct {
 
 type CreateApplicationProfileOutput struct {
 	ApplicationProfile *api.ApplicationProfile `json:"applicationProfile"`
+	GeneratedPassword  *string                 `json:"generatedPassword"`
 	Success            bool                    `json:"success"`
 }
 
+type IssueDeviceCertificateOutput struct {
+	DeviceCertificate    *certificates.Certificate `json:"deviceCertificate"`
+	PrivateKey           *string                   `json:"privateKey"`
+	CertificateAuthority string                    `json:"certificateAuthority"`
+	Success              bool                      `json:"success"`
+}
+
+type Quotas struct {
+	MaxApplications        *int `json:"maxApplications"`
+	MaxApplicationProfiles *int `json:"maxApplicationProfiles"`
+	MaxStoredBytes         *int `json:"maxStoredBytes"`
+}
+
+type RecordMatch struct {
+	Record     *api1.Record        `json:"record"`
+	Highlights []*search.Highlight `json:"highlights"`
+}
+
+type RecordSearchResult struct {
+	Matches        []*RecordMatch `json:"matches"`
+	ScannedRecords int            `json:"scannedRecords"`
+	Truncated      bool           `json:"truncated"`
+}
+
+type RequestAccountDeletionOutput struct {
+	ConfirmationToken string           `json:"confirmationToken"`
+	Deletion          *AccountDeletion `json:"deletion"`
+}
+
+// Limits the records shown for the topics matching pattern: records older than retentionSeconds (15 days by default),
+// and the oldest records exceeding maxSizeInBytes, are hidden from queries and exports. Nothing is purged from storage.
+type RetentionPolicy struct {
+	ApplicationID    string `json:"applicationId"`
+	Pattern          string `json:"pattern"`
+	RetentionSeconds *int   `json:"retentionSeconds"`
+	MaxSizeInBytes   *int   `json:"maxSizeInBytes"`
+}
+
 type SessionConnectedEvent struct {
-	Session *api1.SessionMetadatas `json:"session"`
+	Session *api2.SessionMetadatas `json:"session"`
 }
 
 func (SessionConnectedEvent) IsAuditEventPayload() {}
@@ -66,6 +149,209 @@ type SessionDisconnectedEvent struct {
 
 func (SessionDisconnectedEvent) IsAuditEventPayload() {}
 
+type SetProtobufDescriptorSetOutput struct {
+	MessageTypes []string `json:"messageTypes"`
+	Success      bool     `json:"success"`
+}
+
+type SetRetainedMessageInput struct {
+	ApplicationID string           `json:"applicationId"`
+	TopicName     string           `json:"topicName"`
+	Payload       string           `json:"payload"`
+	Encoding      *PayloadEncoding `json:"encoding"`
+	Qos           *int             `json:"qos"`
+}
+
+type SetRetainedMessageOutput struct {
+	RetainedMessage *api2.RetainedMessage `json:"retainedMessage"`
+	Success         bool                  `json:"success"`
+}
+
+type SetRetentionPolicyInput struct {
+	ApplicationID    string  `json:"applicationId"`
+	Pattern          *string `json:"pattern"`
+	RetentionSeconds *int    `json:"retentionSeconds"`
+	MaxSizeInBytes   *int    `json:"maxSizeInBytes"`
+}
+
+type SetRetentionPolicyOutput struct {
+	RetentionPolicy *RetentionPolicy `json:"retentionPolicy"`
+	Success         bool             `json:"success"`
+}
+
+type SetTopicSchemaInput struct {
+	ApplicationID string          `json:"applicationId"`
+	Pattern       string          `json:"pattern"`
+	Type          TopicSchemaType `json:"type"`
+	JSONSchema    *string         `json:"jsonSchema"`
+	MessageType   *string         `json:"messageType"`
+}
+
+type SetTopicSchemaOutput struct {
+	TopicSchema *TopicSchema `json:"topicSchema"`
+	Success     bool         `json:"success"`
+}
+
+type TopicSchema struct {
+	ApplicationID string          `json:"applicationId"`
+	Pattern       string          `json:"pattern"`
+	Type          TopicSchemaType `json:"type"`
+	JSONSchema    *string         `json:"jsonSchema"`
+	MessageType   *string         `json:"messageType"`
+}
+
+type TopicTreeNode struct {
+	Name         string              `json:"name"`
+	Path         string              `json:"path"`
+	ChildCount   int                 `json:"childCount"`
+	MessageCount int                 `json:"messageCount"`
+	SizeInBytes  int                 `json:"sizeInBytes"`
+	LastActivity *time.Time          `json:"lastActivity"`
+	Topic        *api1.TopicMetadata `json:"topic"`
+	Children     []*TopicTreeNode    `json:"children"`
+}
+
+type AccountDeletionStatus string
+
+const (
+	AccountDeletionStatusRequested  AccountDeletionStatus = "REQUESTED"
+	AccountDeletionStatusInProgress AccountDeletionStatus = "IN_PROGRESS"
+	AccountDeletionStatusFailed     AccountDeletionStatus = "FAILED"
+	AccountDeletionStatusIncomplete AccountDeletionStatus = "INCOMPLETE"
+	AccountDeletionStatusCompleted  AccountDeletionStatus = "COMPLETED"
+)
+
+var AllAccountDeletionStatus = []AccountDeletionStatus{
+	AccountDeletionStatusRequested,
+	AccountDeletionStatusInProgress,
+	AccountDeletionStatusFailed,
+	AccountDeletionStatusIncomplete,
+	AccountDeletionStatusCompleted,
+}
+
+func (e AccountDeletionStatus) IsValid() bool {
+	switch e {
+	case AccountDeletionStatusRequested, AccountDeletionStatusInProgress, AccountDeletionStatusFailed, AccountDeletionStatusIncomplete, AccountDeletionStatusCompleted:
+		return true
+	}
+	return false
+}
+
+func (e AccountDeletionStatus) String() string {
+	return string(e)
+}
+
+func (e *AccountDeletionStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccountDeletionStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccountDeletionStatus", str)
+	}
+	return nil
+}
+
+func (e AccountDeletionStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AccountDeletionStepName string
+
+const (
+	AccountDeletionStepNameDisconnectSessions        AccountDeletionStepName = "DISCONNECT_SESSIONS"
+	AccountDeletionStepNameDeleteApplicationProfiles AccountDeletionStepName = "DELETE_APPLICATION_PROFILES"
+	AccountDeletionStepNameDeleteApplications        AccountDeletionStepName = "DELETE_APPLICATIONS"
+	AccountDeletionStepNamePurgeRecords              AccountDeletionStepName = "PURGE_RECORDS"
+	AccountDeletionStepNameDeleteConfiguration       AccountDeletionStepName = "DELETE_CONFIGURATION"
+	AccountDeletionStepNameDeleteAccount             AccountDeletionStepName = "DELETE_ACCOUNT"
+)
+
+var AllAccountDeletionStepName = []AccountDeletionStepName{
+	AccountDeletionStepNameDisconnectSessions,
+	AccountDeletionStepNameDeleteApplicationProfiles,
+	AccountDeletionStepNameDeleteApplications,
+	AccountDeletionStepNamePurgeRecords,
+	AccountDeletionStepNameDeleteConfiguration,
+	AccountDeletionStepNameDeleteAccount,
+}
+
+func (e AccountDeletionStepName) IsValid() bool {
+	switch e {
+	case AccountDeletionStepNameDisconnectSessions, AccountDeletionStepNameDeleteApplicationProfiles, AccountDeletionStepNameDeleteApplications, AccountDeletionStepNamePurgeRecords, AccountDeletionStepNameDeleteConfiguration, AccountDeletionStepNameDeleteAccount:
+		return true
+	}
+	return false
+}
+
+func (e AccountDeletionStepName) String() string {
+	return string(e)
+}
+
+func (e *AccountDeletionStepName) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccountDeletionStepName(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccountDeletionStepName", str)
+	}
+	return nil
+}
+
+func (e AccountDeletionStepName) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AccountDeletionStepState string
+
+const (
+	AccountDeletionStepStatePending AccountDeletionStepState = "PENDING"
+	AccountDeletionStepStateDone    AccountDeletionStepState = "DONE"
+	AccountDeletionStepStateFailed  AccountDeletionStepState = "FAILED"
+	AccountDeletionStepStateSkipped AccountDeletionStepState = "SKIPPED"
+)
+
+var AllAccountDeletionStepState = []AccountDeletionStepState{
+	AccountDeletionStepStatePending,
+	AccountDeletionStepStateDone,
+	AccountDeletionStepStateFailed,
+	AccountDeletionStepStateSkipped,
+}
+
+func (e AccountDeletionStepState) IsValid() bool {
+	switch e {
+	case AccountDeletionStepStatePending, AccountDeletionStepStateDone, AccountDeletionStepStateFailed, AccountDeletionStepStateSkipped:
+		return true
+	}
+	return false
+}
+
+func (e AccountDeletionStepState) String() string {
+	return string(e)
+}
+
+func (e *AccountDeletionStepState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccountDeletionStepState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccountDeletionStepState", str)
+	}
+	return nil
+}
+
+func (e AccountDeletionStepState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type AuditEventType string
 
 const (
@@ -114,3 +400,306 @@ func (e *AuditEventType) UnmarshalGQL(v interface{}) error {
 func (e AuditEventType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type ConfigurationChangeAction string
+
+const (
+	ConfigurationChangeActionCreate    ConfigurationChangeAction = "CREATE"
+	ConfigurationChangeActionDelete    ConfigurationChangeAction = "DELETE"
+	ConfigurationChangeActionUnchanged ConfigurationChangeAction = "UNCHANGED"
+)
+
+var AllConfigurationChangeAction = []ConfigurationChangeAction{
+	ConfigurationChangeActionCreate,
+	ConfigurationChangeActionDelete,
+	ConfigurationChangeActionUnchanged,
+}
+
+func (e ConfigurationChangeAction) IsValid() bool {
+	switch e {
+	case ConfigurationChangeActionCreate, ConfigurationChangeActionDelete, ConfigurationChangeActionUnchanged:
+		return true
+	}
+	return false
+}
+
+func (e ConfigurationChangeAction) String() string {
+	return string(e)
+}
+
+func (e *ConfigurationChangeAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConfigurationChangeAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ConfigurationChangeAction", str)
+	}
+	return nil
+}
+
+func (e ConfigurationChangeAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ConfigurationResourceKind string
+
+const (
+	ConfigurationResourceKindApplication        ConfigurationResourceKind = "APPLICATION"
+	ConfigurationResourceKindApplicationProfile ConfigurationResourceKind = "APPLICATION_PROFILE"
+)
+
+var AllConfigurationResourceKind = []ConfigurationResourceKind{
+	ConfigurationResourceKindApplication,
+	ConfigurationResourceKindApplicationProfile,
+}
+
+func (e ConfigurationResourceKind) IsValid() bool {
+	switch e {
+	case ConfigurationResourceKindApplication, ConfigurationResourceKindApplicationProfile:
+		return true
+	}
+	return false
+}
+
+func (e ConfigurationResourceKind) String() string {
+	return string(e)
+}
+
+func (e *ConfigurationResourceKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConfigurationResourceKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ConfigurationResourceKind", str)
+	}
+	return nil
+}
+
+func (e ConfigurationResourceKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PayloadEncoding string
+
+const (
+	PayloadEncodingUTF8   PayloadEncoding = "UTF8"
+	PayloadEncodingBase64 PayloadEncoding = "BASE64"
+	PayloadEncodingHex    PayloadEncoding = "HEX"
+)
+
+var AllPayloadEncoding = []PayloadEncoding{
+	PayloadEncodingUTF8,
+	PayloadEncodingBase64,
+	PayloadEncodingHex,
+}
+
+func (e PayloadEncoding) IsValid() bool {
+	switch e {
+	case PayloadEncodingUTF8, PayloadEncodingBase64, PayloadEncodingHex:
+		return true
+	}
+	return false
+}
+
+func (e PayloadEncoding) String() string {
+	return string(e)
+}
+
+func (e *PayloadEncoding) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PayloadEncoding(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PayloadEncoding", str)
+	}
+	return nil
+}
+
+func (e PayloadEncoding) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PayloadFormat string
+
+const (
+	PayloadFormatJSON     PayloadFormat = "JSON"
+	PayloadFormatCbor     PayloadFormat = "CBOR"
+	PayloadFormatMsgpack  PayloadFormat = "MSGPACK"
+	PayloadFormatProtobuf PayloadFormat = "PROTOBUF"
+)
+
+var AllPayloadFormat = []PayloadFormat{
+	PayloadFormatJSON,
+	PayloadFormatCbor,
+	PayloadFormatMsgpack,
+	PayloadFormatProtobuf,
+}
+
+func (e PayloadFormat) IsValid() bool {
+	switch e {
+	case PayloadFormatJSON, PayloadFormatCbor, PayloadFormatMsgpack, PayloadFormatProtobuf:
+		return true
+	}
+	return false
+}
+
+func (e PayloadFormat) String() string {
+	return string(e)
+}
+
+func (e *PayloadFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PayloadFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PayloadFormat", str)
+	}
+	return nil
+}
+
+func (e PayloadFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SearchMode string
+
+const (
+	SearchModeSubstring SearchMode = "SUBSTRING"
+	SearchModeRegex     SearchMode = "REGEX"
+	SearchModeField     SearchMode = "FIELD"
+)
+
+var AllSearchMode = []SearchMode{
+	SearchModeSubstring,
+	SearchModeRegex,
+	SearchModeField,
+}
+
+func (e SearchMode) IsValid() bool {
+	switch e {
+	case SearchModeSubstring, SearchModeRegex, SearchModeField:
+		return true
+	}
+	return false
+}
+
+func (e SearchMode) String() string {
+	return string(e)
+}
+
+func (e *SearchMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchMode", str)
+	}
+	return nil
+}
+
+func (e SearchMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SeriesAggregate string
+
+const (
+	SeriesAggregateAvg  SeriesAggregate = "AVG"
+	SeriesAggregateMin  SeriesAggregate = "MIN"
+	SeriesAggregateMax  SeriesAggregate = "MAX"
+	SeriesAggregateSum  SeriesAggregate = "SUM"
+	SeriesAggregateLast SeriesAggregate = "LAST"
+)
+
+var AllSeriesAggregate = []SeriesAggregate{
+	SeriesAggregateAvg,
+	SeriesAggregateMin,
+	SeriesAggregateMax,
+	SeriesAggregateSum,
+	SeriesAggregateLast,
+}
+
+func (e SeriesAggregate) IsValid() bool {
+	switch e {
+	case SeriesAggregateAvg, SeriesAggregateMin, SeriesAggregateMax, SeriesAggregateSum, SeriesAggregateLast:
+		return true
+	}
+	return false
+}
+
+func (e SeriesAggregate) String() string {
+	return string(e)
+}
+
+func (e *SeriesAggregate) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SeriesAggregate(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SeriesAggregate", str)
+	}
+	return nil
+}
+
+func (e SeriesAggregate) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TopicSchemaType string
+
+const (
+	TopicSchemaTypeJSONSchema TopicSchemaType = "JSON_SCHEMA"
+	TopicSchemaTypeProtobuf   TopicSchemaType = "PROTOBUF"
+)
+
+var AllTopicSchemaType = []TopicSchemaType{
+	TopicSchemaTypeJSONSchema,
+	TopicSchemaTypeProtobuf,
+}
+
+func (e TopicSchemaType) IsValid() bool {
+	switch e {
+	case TopicSchemaTypeJSONSchema, TopicSchemaTypeProtobuf:
+		return true
+	}
+	return false
+}
+
+func (e TopicSchemaType) String() string {
+	return string(e)
+}
+
+func (e *TopicSchemaType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TopicSchemaType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TopicSchemaType", str)
+	}
+	return nil
+}
+
+func (e TopicSchemaType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
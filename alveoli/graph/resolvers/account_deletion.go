@@ -0,0 +1,65 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/deletion"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+func accountDeletionToModel(job *deletion.Job) *model.AccountDeletion {
+	out := &model.AccountDeletion{
+		RequestedAt: job.RequestedAt,
+		ExpiresAt:   job.ExpiresAt,
+		ConfirmedAt: job.ConfirmedAt,
+		FinishedAt:  job.FinishedAt,
+		CompletedAt: job.CompletedAt,
+		Completed:   job.Status() == deletion.StatusCompleted,
+		Status:      model.AccountDeletionStatus(job.Status()),
+		Steps:       make([]*model.AccountDeletionStep, len(job.Steps)),
+	}
+	for idx, step := range job.Steps {
+		out.Steps[idx] = &model.AccountDeletionStep{
+			Name:      model.AccountDeletionStepName(step.Name),
+			State:     model.AccountDeletionStepState(step.State),
+			Processed: step.Processed,
+			Remaining: step.Remaining,
+		}
+		if step.Message != "" {
+			message := step.Message
+			out.Steps[idx].Message = &message
+		}
+	}
+	return out
+}
+
+func (m *mutationResolver) RequestAccountDeletion(ctx context.Context) (*model.RequestAccountDeletionOutput, error) {
+	authContext := auth.Informations(ctx)
+	job, token, err := m.deleter.Request(ctx, authContext.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.RequestAccountDeletionOutput{
+		ConfirmationToken: token,
+		Deletion:          accountDeletionToModel(job),
+	}, nil
+}
+
+func (m *mutationResolver) ConfirmAccountDeletion(ctx context.Context, confirmationToken string) (*model.AccountDeletion, error) {
+	authContext := auth.Informations(ctx)
+	job, err := m.deleter.Run(ctx, authContext.AccountID, confirmationToken)
+	if err != nil {
+		return nil, err
+	}
+	return accountDeletionToModel(job), nil
+}
+
+func (a *accountResolver) Deletion(ctx context.Context, obj *vespiary.Account) (*model.AccountDeletion, error) {
+	job, err := a.deleter.Load(ctx, obj.ID)
+	if err != nil || job == nil {
+		return nil, err
+	}
+	return accountDeletionToModel(job), nil
+}
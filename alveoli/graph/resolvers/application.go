@@ -2,11 +2,9 @@ package resolvers
 
 import (
 	"context"
-	"fmt"
-	"io"
-	"time"
 
 	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
 	nest "github.com/vx-labs/nest/nest/api"
 	vespiary "github.com/vx-labs/vespiary/vespiary/api"
 )
@@ -37,39 +35,20 @@ func (a *applicationResolver) Profiles(ctx context.Context, obj *vespiary.Applic
 }
 func (a *applicationResolver) Records(ctx context.Context, obj *vespiary.Application, userPattern *string) ([]*nest.Record, error) {
 	authContext := auth.Informations(ctx)
-	pattern := "#"
-	if userPattern != nil {
-		pattern = *userPattern
-	}
-	finalPattern := []byte(fmt.Sprintf("_root/%s/%s/%s", authContext.AccountID, obj.ID, pattern))
-
-	stream, err := a.nest.GetTopics(ctx, &nest.GetTopicsRequest{
-		Pattern:       finalPattern,
-		Watch:         false,
-		FromTimestamp: time.Now().Add(-15 * 24 * time.Hour).UnixNano(),
-	})
+	pattern, err := userFilter(userPattern)
 	if err != nil {
 		return nil, err
 	}
-	out := []*nest.Record{}
-	for {
-		msg, err := stream.Recv()
-		if err != nil {
-			if err == io.EOF {
-				return out, nil
-			}
-			return nil, err
-		}
-		out = append(out, msg.Records...)
-	}
+	finalPattern := tenancy.TopicPattern(authContext.AccountID, obj.ID, pattern)
+	return a.retainedRecords(ctx, authContext.AccountID, obj.ID, finalPattern)
 }
 func (a *applicationResolver) Topics(ctx context.Context, obj *vespiary.Application, userPattern *string) ([]*nest.TopicMetadata, error) {
 	authContext := auth.Informations(ctx)
-	pattern := "#"
-	if userPattern != nil {
-		pattern = *userPattern
+	pattern, err := userFilter(userPattern)
+	if err != nil {
+		return nil, err
 	}
-	finalPattern := []byte(fmt.Sprintf("_root/%s/%s/%s", authContext.AccountID, obj.ID, pattern))
+	finalPattern := tenancy.TopicPattern(authContext.AccountID, obj.ID, pattern)
 	out, err := a.nest.ListTopics(ctx, &nest.ListTopicsRequest{
 		Pattern: finalPattern,
 	})
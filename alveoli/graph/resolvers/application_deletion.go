@@ -0,0 +1,190 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/certificates"
+	"github.com/vx-labs/alveoli/alveoli/retention"
+	"github.com/vx-labs/alveoli/alveoli/store"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ApplicationInUseError is returned when deleting an application which still has profiles or stored records,
+// unless the deletion is forced. Applications with connected sessions are never deleted, since the broker
+// cannot disconnect them.
+type ApplicationInUseError struct {
+	Profiles int
+	Sessions int
+	Topics   int
+}
+
+func (e *ApplicationInUseError) Error() string {
+	dependents := []string{}
+	for _, dependent := range []struct {
+		count int
+		name  string
+	}{{e.Profiles, "profile"}, {e.Sessions, "connected session"}, {e.Topics, "topic"}} {
+		if dependent.count == 0 {
+			continue
+		}
+		name := dependent.name
+		if dependent.count > 1 {
+			name += "s"
+		}
+		dependents = append(dependents, fmt.Sprintf("%d %s", dependent.count, name))
+	}
+	if e.Sessions > 0 {
+		return fmt.Sprintf("application still has %s: sessions must disconnect before the application is deleted", strings.Join(dependents, ", "))
+	}
+	return fmt.Sprintf("application still has %s: use force to delete it anyway", strings.Join(dependents, ", "))
+}
+
+// existingApplications returns the IDs of the applications of an account.
+// Records of deleted applications cannot be removed from nest, so topics whose application is not part of
+// this set must be hidden.
+func (r *resolver) existingApplications(ctx context.Context, accountID string) (map[string]struct{}, error) {
+	applications, err := r.vespiary.ListApplicationsByAccountID(ctx, &vespiary.ListApplicationsByAccountIDRequest{
+		AccountID: accountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]struct{}, len(applications.Applications))
+	for _, application := range applications.Applications {
+		out[application.ID] = struct{}{}
+	}
+	return out, nil
+}
+
+// belongsToApplications returns true if topic was published in one of applications.
+func belongsToApplications(applications map[string]struct{}, topic []byte) bool {
+	name, err := tenancy.ParseTopic(topic)
+	if err != nil {
+		return false
+	}
+	_, ok := applications[name.ApplicationID]
+	return ok
+}
+
+// applicationDependents returns the profiles, the connected sessions and the topics of an application.
+func (r *resolver) applicationDependents(ctx context.Context, accountID, applicationID string) ([]*vespiary.ApplicationProfile, []*wasp.SessionMetadatas, []*nest.TopicMetadata, error) {
+	profiles, err := r.vespiary.ListApplicationProfilesByApplication(ctx, &vespiary.ListApplicationProfilesByApplicationRequest{
+		AccountID:     accountID,
+		ApplicationID: applicationID,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sessions, err := r.wasp.ListSessionMetadatas(ctx, &wasp.ListSessionMetadatasRequest{})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	connected := []*wasp.SessionMetadatas{}
+	for _, session := range sessions.SessionMetadatasList {
+		if tenancy.OwnsMountPoint(accountID, applicationID, session.MountPoint) {
+			connected = append(connected, session)
+		}
+	}
+	topics, err := r.nest.ListTopics(ctx, &nest.ListTopicsRequest{
+		Pattern: tenancy.TopicPattern(accountID, applicationID, "#"),
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return profiles.ApplicationProfiles, connected, topics.TopicMetadatas, nil
+}
+
+// deleteApplicationConfiguration removes the protobuf descriptors, the topic schemas and the retention policies
+// attached to an application, and revokes the device certificates of its profiles.
+func (r *resolver) deleteApplicationConfiguration(ctx context.Context, accountID, applicationID string) error {
+	_, err := r.store.Get(ctx, accountID, protobufDescriptorsStoreKind, applicationID)
+	if err == nil {
+		err = r.store.Delete(ctx, accountID, protobufDescriptorsStoreKind, applicationID)
+	}
+	if err != nil && err != store.ErrNotFound {
+		return err
+	}
+	for _, kind := range []string{topicSchemasStoreKind, retention.StoreKind} {
+		values, err := r.store.List(ctx, accountID, kind)
+		if err != nil {
+			return err
+		}
+		for key := range values {
+			if !strings.HasPrefix(key, applicationID+"/") {
+				continue
+			}
+			err := r.store.Delete(ctx, accountID, kind, key)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	_, err = r.authority.RevokeAll(ctx, accountID, func(cert certificates.Certificate) bool {
+		return cert.ApplicationID == applicationID
+	})
+	if err != nil {
+		return err
+	}
+	r.descriptors.invalidate(accountID + "/" + applicationID)
+	r.validators.invalidate(accountID + "/" + applicationID)
+	return nil
+}
+
+// DeleteApplication deletes an application with its profiles, retained messages and configuration. Applications
+// with profiles or stored records are only deleted when force is set, and applications with connected sessions are
+// never deleted. Stored records cannot be removed from nest: they are hidden once the application is deleted.
+func (m *mutationResolver) DeleteApplication(ctx context.Context, id string, force *bool) (string, error) {
+	authContext := auth.Informations(ctx)
+	_, err := m.vespiary.GetApplicationByAccountID(ctx, &vespiary.GetApplicationByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		Id:        id,
+	})
+	if err != nil {
+		return id, err
+	}
+	profiles, sessions, topics, err := m.applicationDependents(ctx, authContext.AccountID, id)
+	if err != nil {
+		return id, err
+	}
+	if len(sessions) > 0 || ((force == nil || !*force) && len(profiles)+len(topics) > 0) {
+		return id, &ApplicationInUseError{Profiles: len(profiles), Sessions: len(sessions), Topics: len(topics)}
+	}
+	for _, profile := range profiles {
+		_, err := m.vespiary.DeleteApplicationProfileByAccountID(ctx, &vespiary.DeleteApplicationProfileByAccountIDRequest{
+			AccountID: authContext.AccountID,
+			ID:        profile.ID,
+		})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return id, err
+		}
+	}
+	retained, err := m.wasp.ListRetainedMessages(ctx, &wasp.ListRetainedMessagesRequest{
+		Pattern: tenancy.TopicPattern(authContext.AccountID, id, "#"),
+	})
+	if err != nil {
+		return id, err
+	}
+	for _, message := range retained.RetainedMessages {
+		_, err := m.wasp.DeleteRetainedMessage(ctx, &wasp.DeleteRetainedMessageRequest{Topic: message.Publish.Topic})
+		if err != nil {
+			return id, err
+		}
+	}
+	err = m.deleteApplicationConfiguration(ctx, authContext.AccountID, id)
+	if err != nil {
+		return id, err
+	}
+	_, err = m.vespiary.DeleteApplicationByAccountID(ctx, &vespiary.DeleteApplicationByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		ID:        id,
+	})
+	return id, err
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/provisioning"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
 	vespiary "github.com/vx-labs/vespiary/vespiary/api"
 )
 
@@ -36,3 +38,39 @@ func (a *applicationProfileResolver) Application(ctx context.Context, obj *vespi
 func (a *applicationProfileResolver) Enabled(ctx context.Context, obj *vespiary.ApplicationProfile) (bool, error) {
 	return obj.Enabled, nil
 }
+
+// profileUsername returns the MQTT username of a profile. The broker resolves its first part as an account name.
+func (r *resolver) profileUsername(ctx context.Context, obj *vespiary.ApplicationProfile) (string, error) {
+	authContext := auth.Informations(ctx)
+	account, err := r.account(ctx)
+	if err != nil {
+		return "", err
+	}
+	application, err := r.vespiary.GetApplicationByAccountID(ctx, &vespiary.GetApplicationByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		Id:        obj.ApplicationID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return provisioning.Username(account.Name, application.Application.Name, obj.Name)
+}
+
+// Connection returns the details devices need to connect with the profile.
+func (a *applicationProfileResolver) Connection(ctx context.Context, obj *vespiary.ApplicationProfile) (*provisioning.Connection, error) {
+	authContext := auth.Informations(ctx)
+	username, err := a.profileUsername(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	broker := a.broker
+	if broker.ServerName == "" {
+		broker.ServerName = broker.Host
+	}
+	return &provisioning.Connection{
+		Broker:       broker,
+		Username:     username,
+		ClientIDHint: provisioning.ClientIDHint(obj.Name),
+		MountPoint:   tenancy.MountPoint(authContext.AccountID, obj.ApplicationID),
+	}, nil
+}
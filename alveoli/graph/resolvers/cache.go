@@ -0,0 +1,70 @@
+package resolvers
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	cacheTTL = 30 * time.Second
+	// cacheMaxEntries bounds the memory used by a cache, since some keys, like statistics time ranges, are chosen
+	// by callers.
+	cacheMaxEntries = 1024
+)
+
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// cache avoids reading and parsing applications configuration, like descriptor sets or topic schemas,
+// for each resolved record.
+type cache struct {
+	mtx     sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func newCache() *cache {
+	return &cache{entries: map[string]cacheEntry{}}
+}
+
+func (c *cache) get(key string) (interface{}, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.value, true
+}
+func (c *cache) set(key string, value interface{}) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	now := time.Now()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= cacheMaxEntries {
+		c.evict(now)
+	}
+	c.entries[key] = cacheEntry{value: value, expiresAt: now.Add(cacheTTL)}
+}
+
+// evict removes expired entries, and the entry closest to expiration when the cache is still full.
+func (c *cache) evict(now time.Time) {
+	oldest := ""
+	for key, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldest == "" || entry.expiresAt.Before(c.entries[oldest].expiresAt) {
+			oldest = key
+		}
+	}
+	if len(c.entries) >= cacheMaxEntries {
+		delete(c.entries, oldest)
+	}
+}
+func (c *cache) invalidate(key string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	delete(c.entries, key)
+}
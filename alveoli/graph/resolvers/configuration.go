@@ -0,0 +1,155 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/configuration"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+func configurationChangeToModel(change configuration.Change) *model.ConfigurationChange {
+	out := &model.ConfigurationChange{
+		Kind:        model.ConfigurationResourceKind(change.Kind),
+		Action:      model.ConfigurationChangeAction(change.Action),
+		Application: change.Application,
+		Name:        change.Name,
+	}
+	if change.ID != "" {
+		id := change.ID
+		out.ID = &id
+	}
+	if change.Reason != "" {
+		reason := change.Reason
+		out.Reason = &reason
+	}
+	return out
+}
+
+// applyConfigurationChange carries out change, and returns the ID of the resulting resource.
+// applicationIDs maps application names to their IDs, and is updated when applications are created.
+func (m *mutationResolver) applyConfigurationChange(ctx context.Context, change configuration.Change, applicationIDs map[string]string) (string, error) {
+	switch change.Kind {
+	case configuration.KindApplication:
+		switch change.Action {
+		case configuration.Create:
+			out, err := m.CreateApplication(ctx, vespiary.CreateApplicationRequest{Name: change.Name})
+			if err != nil {
+				return "", err
+			}
+			applicationIDs[change.Name] = out.Application.ID
+			return out.Application.ID, nil
+		case configuration.Delete:
+			// Pruned applications are deleted with their dependents.
+			force := true
+			return m.DeleteApplication(ctx, change.ID, &force)
+		}
+	case configuration.KindApplicationProfile:
+		switch change.Action {
+		case configuration.Create:
+			out, err := m.CreateApplicationProfile(ctx, vespiary.CreateApplicationProfileRequest{
+				Name:          change.Name,
+				ApplicationID: applicationIDs[change.Application],
+				Password:      change.Password,
+			})
+			if err != nil {
+				return "", err
+			}
+			return out.ApplicationProfile.ID, nil
+		case configuration.Delete:
+			_, err := m.DeleteApplicationProfile(ctx, change.ID)
+			return change.ID, err
+		}
+	}
+	return change.ID, nil
+}
+
+// warnCertificateRevocations appends to the reason of deleted profiles the number of
+// valid device certificates their change revokes. Pruned applications always have their profiles deleted first,
+// so their own changes are not annotated.
+func (m *mutationResolver) warnCertificateRevocations(ctx context.Context, accountID string, changes []configuration.Change) error {
+	certs, err := m.authority.List(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	byProfile := map[string]int{}
+	for _, cert := range certs {
+		if cert.RevokedAt == nil && now.Before(cert.NotAfter) {
+			byProfile[cert.ApplicationProfileID]++
+		}
+	}
+	for idx := range changes {
+		change := &changes[idx]
+		if change.Kind != configuration.KindApplicationProfile || change.Action != configuration.Delete {
+			continue
+		}
+		count := byProfile[change.ID]
+		if count == 0 {
+			continue
+		}
+		warning := "revokes 1 device certificate"
+		if count > 1 {
+			warning = fmt.Sprintf("revokes %d device certificates", count)
+		}
+		if change.Reason == "" {
+			change.Reason = warning
+		} else {
+			change.Reason += ", " + warning
+		}
+	}
+	return nil
+}
+
+func (m *mutationResolver) ApplyConfiguration(ctx context.Context, input model.ApplyConfigurationInput) (*model.ApplyConfigurationOutput, error) {
+	authContext := auth.Informations(ctx)
+	doc, err := configuration.Parse([]byte(input.Document))
+	if err != nil {
+		return nil, err
+	}
+	applications, err := m.vespiary.ListApplicationsByAccountID(ctx, &vespiary.ListApplicationsByAccountIDRequest{
+		AccountID: authContext.AccountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := m.vespiary.ListApplicationProfilesByAccountID(ctx, &vespiary.ListApplicationProfilesByAccountIDRequest{
+		AccountID: authContext.AccountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	changes, err := configuration.Diff(doc, applications.Applications, profiles.ApplicationProfiles, input.Prune != nil && *input.Prune)
+	if err != nil {
+		return nil, err
+	}
+	err = m.warnCertificateRevocations(ctx, authContext.AccountID, changes)
+	if err != nil {
+		return nil, err
+	}
+	out := &model.ApplyConfigurationOutput{
+		Changes: make([]*model.ConfigurationChange, len(changes)),
+		DryRun:  input.DryRun != nil && *input.DryRun,
+		Success: true,
+	}
+	applicationIDs := map[string]string{}
+	for _, application := range applications.Applications {
+		applicationIDs[application.Name] = application.ID
+	}
+	for idx, change := range changes {
+		if !out.DryRun {
+			// Changes are applied in order: applying the document again after a failure resumes the work.
+			change.ID, err = m.applyConfigurationChange(ctx, change, applicationIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to %s %s %q: %w", strings.ToLower(string(change.Action)),
+					strings.ToLower(strings.Replace(string(change.Kind), "_", " ", -1)), change.Name, err)
+			}
+		}
+		out.Changes[idx] = configurationChangeToModel(change)
+	}
+	return out, nil
+}
@@ -0,0 +1,83 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/certificates"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+func (a *accountResolver) DeviceCertificateAuthority(ctx context.Context, obj *vespiary.Account) (*string, error) {
+	out, err := a.authority.Certificate(ctx, obj.ID)
+	if err == certificates.ErrNoAuthority {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (r *queryResolver) DeviceCertificates(ctx context.Context, applicationProfileID *string) ([]*certificates.Certificate, error) {
+	authContext := auth.Informations(ctx)
+	certs, err := r.authority.List(ctx, authContext.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	out := []*certificates.Certificate{}
+	for idx := range certs {
+		if applicationProfileID != nil && certs[idx].ApplicationProfileID != *applicationProfileID {
+			continue
+		}
+		out = append(out, &certs[idx])
+	}
+	return out, nil
+}
+
+func (m *mutationResolver) IssueDeviceCertificate(ctx context.Context, applicationProfileID string, csr *string) (*model.IssueDeviceCertificateOutput, error) {
+	authContext := auth.Informations(ctx)
+	profile, err := m.vespiary.GetApplicationProfileByAccountID(ctx, &vespiary.GetApplicationProfileByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		ID:        applicationProfileID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	username, err := m.profileUsername(ctx, profile.ApplicationProfile)
+	if err != nil {
+		return nil, err
+	}
+	request := ""
+	if csr != nil {
+		request = *csr
+	}
+	issued, err := m.authority.Issue(ctx, certificates.Identity{
+		AccountID:            authContext.AccountID,
+		ApplicationID:        profile.ApplicationProfile.ApplicationID,
+		ApplicationProfileID: profile.ApplicationProfile.ID,
+		Username:             username,
+	}, request)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := m.authority.Certificate(ctx, authContext.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	out := &model.IssueDeviceCertificateOutput{
+		DeviceCertificate:    &issued.Certificate,
+		CertificateAuthority: ca,
+		Success:              true,
+	}
+	if issued.PrivateKey != "" {
+		out.PrivateKey = &issued.PrivateKey
+	}
+	return out, nil
+}
+
+func (m *mutationResolver) RevokeDeviceCertificate(ctx context.Context, serialNumber string) (*certificates.Certificate, error) {
+	authContext := auth.Informations(ctx)
+	return m.authority.Revoke(ctx, authContext.AccountID, serialNumber)
+}
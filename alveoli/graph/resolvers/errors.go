@@ -0,0 +1,16 @@
+package resolvers
+
+import "fmt"
+
+// InputError is returned when the arguments of a query or a mutation are invalid.
+type InputError struct {
+	Reason string
+}
+
+func (e *InputError) Error() string {
+	return e.Reason
+}
+
+func invalidInput(format string, args ...interface{}) error {
+	return &InputError{Reason: fmt.Sprintf(format, args...)}
+}
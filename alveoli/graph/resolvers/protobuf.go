@@ -0,0 +1,91 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/decoding"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/store"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const protobufDescriptorsStoreKind = "protobuf-descriptors"
+
+// protobufDescriptors returns the protobuf descriptors uploaded for the given application, or nil if
+// the application has no descriptor set.
+func (r *resolver) protobufDescriptors(ctx context.Context, accountID, applicationID string) (*protoregistry.Files, error) {
+	cacheKey := accountID + "/" + applicationID
+	if value, ok := r.descriptors.get(cacheKey); ok {
+		files, _ := value.(*protoregistry.Files)
+		return files, nil
+	}
+	data, err := r.store.Get(ctx, accountID, protobufDescriptorsStoreKind, applicationID)
+	if err == store.ErrNotFound {
+		r.descriptors.set(cacheKey, (*protoregistry.Files)(nil))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	files, err := decoding.ParseDescriptorSet(data)
+	if err != nil {
+		return nil, err
+	}
+	r.descriptors.set(cacheKey, files)
+	return files, nil
+}
+
+func (a *applicationResolver) ProtobufMessageTypes(ctx context.Context, obj *vespiary.Application) ([]string, error) {
+	authContext := auth.Informations(ctx)
+	files, err := a.protobufDescriptors(ctx, authContext.AccountID, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if files == nil {
+		return []string{}, nil
+	}
+	return decoding.MessageNames(files), nil
+}
+
+func (m *mutationResolver) SetProtobufDescriptorSet(ctx context.Context, applicationID string, descriptorSet string) (*model.SetProtobufDescriptorSetOutput, error) {
+	authContext := auth.Informations(ctx)
+	_, err := m.vespiary.GetApplicationByAccountID(ctx, &vespiary.GetApplicationByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		Id:        applicationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	data, err := base64.StdEncoding.DecodeString(descriptorSet)
+	if err != nil {
+		return nil, invalidInput("descriptorSet must be a base64-encoded google.protobuf.FileDescriptorSet")
+	}
+	files, err := decoding.ParseDescriptorSet(data)
+	if err != nil {
+		return nil, err
+	}
+	err = m.store.Put(ctx, authContext.AccountID, protobufDescriptorsStoreKind, applicationID, data)
+	if err != nil {
+		return nil, err
+	}
+	m.descriptors.invalidate(authContext.AccountID + "/" + applicationID)
+	m.validators.invalidate(authContext.AccountID + "/" + applicationID)
+	return &model.SetProtobufDescriptorSetOutput{
+		MessageTypes: decoding.MessageNames(files),
+		Success:      true,
+	}, nil
+}
+
+func (m *mutationResolver) DeleteProtobufDescriptorSet(ctx context.Context, applicationID string) (string, error) {
+	authContext := auth.Informations(ctx)
+	err := m.store.Delete(ctx, authContext.AccountID, protobufDescriptorsStoreKind, applicationID)
+	if err != nil {
+		return "", err
+	}
+	m.descriptors.invalidate(authContext.AccountID + "/" + applicationID)
+	m.validators.invalidate(authContext.AccountID + "/" + applicationID)
+	return applicationID, nil
+}
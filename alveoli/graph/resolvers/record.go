@@ -2,13 +2,18 @@ package resolvers
 
 import (
 	"context"
-	"errors"
-	"strings"
+	"encoding/base64"
+	"encoding/hex"
 	"time"
+	"unicode/utf8"
 
 	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/decoding"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
 	nest "github.com/vx-labs/nest/nest/api"
 	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type recordResolver struct {
@@ -16,18 +21,18 @@ type recordResolver struct {
 }
 
 func (r *recordResolver) TopicName(ctx context.Context, obj *nest.Record) (string, error) {
-	tokens := strings.SplitN(string(obj.Topic), "/", 4)
-	if len(tokens) != 4 {
-		return "", errors.New("failed to extract name from topic")
+	topic, err := tenancy.ParseOwnedTopic(auth.Informations(ctx).AccountID, obj.Topic)
+	if err != nil {
+		return "", err
 	}
-	return tokens[3], nil
+	return topic.Name, nil
 }
 func (r *recordResolver) ApplicationID(ctx context.Context, obj *nest.Record) (string, error) {
-	tokens := strings.SplitN(string(obj.Topic), "/", 4)
-	if len(tokens) != 4 {
-		return "", errors.New("failed to extract name from topic")
+	topic, err := tenancy.ParseOwnedTopic(auth.Informations(ctx).AccountID, obj.Topic)
+	if err != nil {
+		return "", err
 	}
-	return tokens[2], nil
+	return topic.ApplicationID, nil
 }
 func (a *recordResolver) Application(ctx context.Context, obj *nest.Record) (*vespiary.Application, error) {
 	authContext := auth.Informations(ctx)
@@ -44,8 +49,111 @@ func (a *recordResolver) Application(ctx context.Context, obj *nest.Record) (*ve
 	}
 	return out.Application, nil
 }
-func (r *recordResolver) Payload(ctx context.Context, obj *nest.Record) (string, error) {
-	return string(obj.Payload), nil
+func (r *recordResolver) Payload(ctx context.Context, obj *nest.Record, encoding *model.PayloadEncoding) (string, error) {
+	return encodePayload(obj.Payload, encoding)
+}
+func (r *recordResolver) PayloadEncoding(ctx context.Context, obj *nest.Record) (model.PayloadEncoding, error) {
+	// GraphQL fields cannot see the encoding requested for payload in the same query: report the detected one.
+	return detectPayloadEncoding(obj.Payload), nil
+}
+func (r *recordResolver) PayloadSize(ctx context.Context, obj *nest.Record) (int, error) {
+	return len(obj.Payload), nil
+}
+func (r *recordResolver) Decoded(ctx context.Context, obj *nest.Record, format *model.PayloadFormat, messageType *string) (interface{}, error) {
+	var message protoreflect.MessageDescriptor
+	authContext := auth.Informations(ctx)
+	applicationID, err := r.ApplicationID(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	if messageType == nil {
+		topicName, err := r.TopicName(ctx, obj)
+		if err != nil {
+			return nil, err
+		}
+		validator, err := r.topicValidator(ctx, authContext.AccountID, applicationID, topicName)
+		if err != nil {
+			return nil, err
+		}
+		if validator != nil {
+			message = validator.Message()
+		}
+	} else {
+		files, err := r.protobufDescriptors(ctx, authContext.AccountID, applicationID)
+		if err != nil {
+			return nil, err
+		}
+		if files == nil {
+			return nil, invalidInput("no protobuf descriptor set uploaded for this application")
+		}
+		message, err = decoding.FindMessage(files, *messageType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if format == nil {
+		out, _, err := decoding.Detect(obj.Payload, message)
+		if err == decoding.ErrUnknownFormat {
+			return nil, nil
+		}
+		return out, err
+	}
+	return decoding.Decode(decoding.Format(*format), obj.Payload, message)
+}
+
+// encodePayload returns payload as a string using the given encoding, or the detected one if encoding is nil.
+func encodePayload(payload []byte, encoding *model.PayloadEncoding) (string, error) {
+	if encoding == nil {
+		detected := detectPayloadEncoding(payload)
+		encoding = &detected
+	}
+	switch *encoding {
+	case model.PayloadEncodingUTF8:
+		if !utf8.Valid(payload) {
+			return "", invalidInput("payload is not valid UTF-8: use BASE64 or HEX encoding")
+		}
+		return string(payload), nil
+	case model.PayloadEncodingBase64:
+		return base64.StdEncoding.EncodeToString(payload), nil
+	case model.PayloadEncodingHex:
+		return hex.EncodeToString(payload), nil
+	default:
+		return "", invalidInput("unsupported payload encoding %q", *encoding)
+	}
+}
+
+// decodePayload is the reverse of encodePayload. Payloads are expected to be UTF-8 strings if encoding is nil.
+func decodePayload(payload string, encoding *model.PayloadEncoding) ([]byte, error) {
+	if encoding == nil {
+		return []byte(payload), nil
+	}
+	switch *encoding {
+	case model.PayloadEncodingUTF8:
+		return []byte(payload), nil
+	case model.PayloadEncodingBase64:
+		out, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return nil, invalidInput("payload is not valid base64")
+		}
+		return out, nil
+	case model.PayloadEncodingHex:
+		out, err := hex.DecodeString(payload)
+		if err != nil {
+			return nil, invalidInput("payload is not valid hex")
+		}
+		return out, nil
+	default:
+		return nil, invalidInput("unsupported payload encoding %q", *encoding)
+	}
+}
+
+// detectPayloadEncoding returns the encoding to use when the user did not request one, so that
+// binary payloads are never returned as invalid strings.
+func detectPayloadEncoding(payload []byte) model.PayloadEncoding {
+	if utf8.Valid(payload) {
+		return model.PayloadEncodingUTF8
+	}
+	return model.PayloadEncodingBase64
 }
 func (r *recordResolver) SentBy(ctx context.Context, obj *nest.Record) (string, error) {
 	return obj.Sender, nil
@@ -3,36 +3,78 @@ package resolvers
 //go:generate go run github.com/99designs/gqlgen --verbose
 import (
 	"context"
-	"fmt"
-	"strings"
 
 	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/certificates"
+	"github.com/vx-labs/alveoli/alveoli/deletion"
 	"github.com/vx-labs/alveoli/alveoli/graph/generated"
 	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/provisioning"
+	"github.com/vx-labs/alveoli/alveoli/store"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	"github.com/vx-labs/alveoli/alveoli/usage"
 	nest "github.com/vx-labs/nest/nest/api"
 	vespiary "github.com/vx-labs/vespiary/vespiary/api"
 	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type resolver struct {
-	nest     nest.MessagesClient
-	wasp     wasp.MQTTClient
-	vespiary vespiary.VespiaryClient
+	nest        nest.MessagesClient
+	wasp        wasp.MQTTClient
+	vespiary    vespiary.VespiaryClient
+	store       *store.Store
+	descriptors *cache
+	validators  *cache
+	statistics  *cache
+	quotas      usage.Limits
+	broker      provisioning.Broker
+	deleter     *deletion.Deleter
+	authority   *certificates.Authority
 }
 
-func Root(waspClient wasp.MQTTClient, vespiaryClient vespiary.VespiaryClient, nestClient nest.MessagesClient) generated.ResolverRoot {
+func Root(waspClient wasp.MQTTClient, vespiaryClient vespiary.VespiaryClient, nestClient nest.MessagesClient, quotas usage.Limits, broker provisioning.Broker, authority *certificates.Authority) generated.ResolverRoot {
+	configuration := store.New(nestClient)
 	return &resolver{
-		nest:     nestClient,
-		wasp:     waspClient,
-		vespiary: vespiaryClient,
+		nest:        nestClient,
+		wasp:        waspClient,
+		vespiary:    vespiaryClient,
+		store:       configuration,
+		descriptors: newCache(),
+		validators:  newCache(),
+		statistics:  newCache(),
+		quotas:      quotas,
+		broker:      broker,
+		deleter:     deletion.NewDeleter(configuration, vespiaryClient, waspClient, nestClient),
+		authority:   authority,
 	}
 }
 
-func (r *queryResolver) Account(ctx context.Context) (*vespiary.Account, error) {
+// account returns the account of the request, as known by vespiary. The name of the authentication context comes
+// from the authentication provider, and is not always the account name the broker resolves usernames with.
+func (r *resolver) account(ctx context.Context) (*vespiary.Account, error) {
 	authContext := auth.Informations(ctx)
+	out, err := r.vespiary.ListAccounts(ctx, &vespiary.ListAccountsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range out.Accounts {
+		if account.ID == authContext.AccountID {
+			return account, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "account not found")
+}
+
+func (r *queryResolver) Account(ctx context.Context) (*vespiary.Account, error) {
+	account, err := r.account(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &vespiary.Account{
-		ID:   authContext.AccountID,
-		Name: authContext.Name,
+		ID:   account.ID,
+		Name: account.Name,
 	}, nil
 }
 func (r *queryResolver) Sessions(ctx context.Context) ([]*wasp.SessionMetadatas, error) {
@@ -43,7 +85,7 @@ func (r *queryResolver) Sessions(ctx context.Context) ([]*wasp.SessionMetadatas,
 	}
 	filtered := make([]*wasp.SessionMetadatas, 0)
 	for _, sessionMetadatas := range out.SessionMetadatasList {
-		if strings.HasPrefix(sessionMetadatas.MountPoint, fmt.Sprintf("_root/%s", authContext.AccountID)) {
+		if tenancy.OwnsMountPoint(authContext.AccountID, "", sessionMetadatas.MountPoint) {
 			filtered = append(filtered, sessionMetadatas)
 		}
 	}
@@ -60,6 +102,17 @@ func (r *queryResolver) Applications(ctx context.Context) ([]*vespiary.Applicati
 	}
 	return out.Applications, nil
 }
+func (r *queryResolver) Application(ctx context.Context, id string) (*vespiary.Application, error) {
+	authContext := auth.Informations(ctx)
+	out, err := r.vespiary.GetApplicationByAccountID(ctx, &vespiary.GetApplicationByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		Id:        id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Application, nil
+}
 
 func (r *queryResolver) ApplicationProfiles(ctx context.Context) ([]*vespiary.ApplicationProfile, error) {
 	authContext := auth.Informations(ctx)
@@ -71,20 +124,41 @@ func (r *queryResolver) ApplicationProfiles(ctx context.Context) ([]*vespiary.Ap
 	}
 	return out.ApplicationProfiles, nil
 }
+func (r *queryResolver) ApplicationProfile(ctx context.Context, id string) (*vespiary.ApplicationProfile, error) {
+	authContext := auth.Informations(ctx)
+	out, err := r.vespiary.GetApplicationProfileByAccountID(ctx, &vespiary.GetApplicationProfileByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		ID:        id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.ApplicationProfile, nil
+}
 func (r *queryResolver) Topics(ctx context.Context, userPattern *string) ([]*nest.TopicMetadata, error) {
 	authContext := auth.Informations(ctx)
-	pattern := "#"
-	if userPattern != nil {
-		pattern = *userPattern
+	pattern, err := userFilter(userPattern)
+	if err != nil {
+		return nil, err
 	}
-	finalPattern := []byte(fmt.Sprintf("_root/%s/+/%s", authContext.AccountID, pattern))
+	finalPattern := tenancy.TopicPattern(authContext.AccountID, tenancy.AnyApplication, pattern)
 	out, err := r.nest.ListTopics(ctx, &nest.ListTopicsRequest{
 		Pattern: finalPattern,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return out.TopicMetadatas, nil
+	applications, err := r.existingApplications(ctx, authContext.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]*nest.TopicMetadata, 0, len(out.TopicMetadatas))
+	for _, metadata := range out.TopicMetadatas {
+		if belongsToApplications(applications, metadata.Name) {
+			filtered = append(filtered, metadata)
+		}
+	}
+	return filtered, nil
 }
 
 type queryResolver struct{ *resolver }
@@ -93,18 +167,16 @@ func (r *resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
 type mutationResolver struct{ *resolver }
 
-func (m *mutationResolver) DeleteAccount(ctx context.Context) (string, error) {
+func (m *mutationResolver) CreateApplication(ctx context.Context, input vespiary.CreateApplicationRequest) (*model.CreateApplicationOutput, error) {
 	authContext := auth.Informations(ctx)
-	_, err := m.vespiary.DeleteAccount(ctx, &vespiary.DeleteAccountRequest{
-		ID: authContext.AccountID,
-	})
+	err := provisioning.ValidateName("application", input.Name)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	err = m.checkApplicationQuotas(ctx, authContext.AccountID)
+	if err != nil {
+		return nil, err
 	}
-	return authContext.AccountID, nil
-}
-func (m *mutationResolver) CreateApplication(ctx context.Context, input vespiary.CreateApplicationRequest) (*model.CreateApplicationOutput, error) {
-	authContext := auth.Informations(ctx)
 	out, err := m.vespiary.CreateApplication(ctx, &vespiary.CreateApplicationRequest{
 		AccountID: authContext.AccountID,
 		Name:      input.Name,
@@ -124,18 +196,39 @@ func (m *mutationResolver) CreateApplication(ctx context.Context, input vespiary
 		Success:     true,
 	}, nil
 }
-func (m *mutationResolver) DeleteApplication(ctx context.Context, id string) (string, error) {
-	authContext := auth.Informations(ctx)
-
-	_, err := m.vespiary.DeleteApplicationByAccountID(ctx, &vespiary.DeleteApplicationByAccountIDRequest{
-		AccountID: authContext.AccountID,
-		ID:        id,
-	})
-	return id, err
-}
 
 func (m *mutationResolver) CreateApplicationProfile(ctx context.Context, input vespiary.CreateApplicationProfileRequest) (*model.CreateApplicationProfileOutput, error) {
 	authContext := auth.Informations(ctx)
+	err := provisioning.ValidateName("application profile", input.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = m.checkApplicationProfileQuotas(ctx, authContext.AccountID, input.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	// Vespiary does not check profile names, and a duplicate would break the lookup of profiles by name.
+	existing, err := m.vespiary.ListApplicationProfilesByApplication(ctx, &vespiary.ListApplicationProfilesByApplicationRequest{
+		AccountID:     authContext.AccountID,
+		ApplicationID: input.ApplicationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range existing.ApplicationProfiles {
+		if profile.Name == input.Name {
+			return nil, status.Error(codes.AlreadyExists, "application profile already exists")
+		}
+	}
+	var generatedPassword *string
+	if input.Password == "" {
+		password, err := provisioning.GeneratePassword()
+		if err != nil {
+			return nil, err
+		}
+		input.Password = password
+		generatedPassword = &password
+	}
 	out, err := m.vespiary.CreateApplicationProfile(ctx, &vespiary.CreateApplicationProfileRequest{
 		AccountID:     authContext.AccountID,
 		Name:          input.Name,
@@ -154,6 +247,7 @@ func (m *mutationResolver) CreateApplicationProfile(ctx context.Context, input v
 	}
 	return &model.CreateApplicationProfileOutput{
 		ApplicationProfile: resp.ApplicationProfile,
+		GeneratedPassword:  generatedPassword,
 		Success:            true,
 	}, nil
 }
@@ -165,6 +259,12 @@ func (m *mutationResolver) DeleteApplicationProfile(ctx context.Context, id stri
 		AccountID: authContext.AccountID,
 		ID:        id,
 	})
+	if err != nil {
+		return id, err
+	}
+	_, err = m.authority.RevokeAll(ctx, authContext.AccountID, func(cert certificates.Certificate) bool {
+		return cert.ApplicationProfileID == id
+	})
 	return id, err
 }
 
@@ -279,3 +379,8 @@ func (r *resolver) Application() generated.ApplicationResolver { return &applica
 func (r *resolver) Record() generated.RecordResolver           { return &recordResolver{r} }
 func (r *resolver) Topic() generated.TopicResolver             { return &topicResolver{r} }
 func (r *resolver) Session() generated.SessionResolver         { return &sessionResolver{r} }
+func (r *resolver) Account() generated.AccountResolver         { return &accountResolver{r} }
+func (r *resolver) Usage() generated.UsageResolver             { return &usageResolver{r} }
+func (r *resolver) RetainedMessage() generated.RetainedMessageResolver {
+	return &retainedMessageResolver{r}
+}
@@ -0,0 +1,141 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	"github.com/vx-labs/alveoli/alveoli/topics"
+	packet "github.com/vx-labs/mqtt-protocol/packet"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+)
+
+type retainedMessageResolver struct {
+	*resolver
+}
+
+func (r *retainedMessageResolver) TopicName(ctx context.Context, obj *wasp.RetainedMessage) (string, error) {
+	topic, err := tenancy.ParseOwnedTopic(auth.Informations(ctx).AccountID, obj.Publish.Topic)
+	if err != nil {
+		return "", err
+	}
+	return topic.Name, nil
+}
+func (r *retainedMessageResolver) ApplicationID(ctx context.Context, obj *wasp.RetainedMessage) (string, error) {
+	topic, err := tenancy.ParseOwnedTopic(auth.Informations(ctx).AccountID, obj.Publish.Topic)
+	if err != nil {
+		return "", err
+	}
+	return topic.ApplicationID, nil
+}
+func (r *retainedMessageResolver) Payload(ctx context.Context, obj *wasp.RetainedMessage, encoding *model.PayloadEncoding) (string, error) {
+	return encodePayload(obj.Publish.Payload, encoding)
+}
+func (r *retainedMessageResolver) PayloadEncoding(ctx context.Context, obj *wasp.RetainedMessage) (model.PayloadEncoding, error) {
+	return detectPayloadEncoding(obj.Publish.Payload), nil
+}
+func (r *retainedMessageResolver) PayloadSize(ctx context.Context, obj *wasp.RetainedMessage) (int, error) {
+	return len(obj.Publish.Payload), nil
+}
+func (r *retainedMessageResolver) Qos(ctx context.Context, obj *wasp.RetainedMessage) (int, error) {
+	return int(obj.Publish.Header.GetQos()), nil
+}
+func (r *retainedMessageResolver) RetainedAt(ctx context.Context, obj *wasp.RetainedMessage) (*time.Time, error) {
+	t := time.Unix(0, obj.LastAdded)
+	return &t, nil
+}
+
+// retainedMessage returns the message retained by wasp on the given topic, or nil.
+func (r *resolver) retainedMessage(ctx context.Context, topic []byte) (*wasp.RetainedMessage, error) {
+	out, err := r.wasp.ListRetainedMessages(ctx, &wasp.ListRetainedMessagesRequest{
+		Pattern: topic,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, message := range out.RetainedMessages {
+		if message.Publish != nil && string(message.Publish.Topic) == string(topic) {
+			return message, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *topicResolver) RetainedMessage(ctx context.Context, obj *nest.TopicMetadata) (*wasp.RetainedMessage, error) {
+	return r.retainedMessage(ctx, obj.Name)
+}
+
+func (m *mutationResolver) SetRetainedMessage(ctx context.Context, input model.SetRetainedMessageInput) (*model.SetRetainedMessageOutput, error) {
+	authContext := auth.Informations(ctx)
+	_, err := m.vespiary.GetApplicationByAccountID(ctx, &vespiary.GetApplicationByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		Id:        input.ApplicationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = topics.ValidateName(input.TopicName)
+	if err != nil {
+		return nil, err
+	}
+	qos := 0
+	if input.Qos != nil {
+		qos = *input.Qos
+	}
+	if qos < 0 || qos > 2 {
+		return nil, invalidInput("qos must be 0, 1 or 2")
+	}
+	payload, err := decodePayload(input.Payload, input.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) == 0 {
+		return nil, invalidInput("payload must not be empty: use clearRetainedMessage to remove a retained message")
+	}
+	err = m.validatePayload(ctx, authContext.AccountID, input.ApplicationID, input.TopicName, payload)
+	if err != nil {
+		return nil, err
+	}
+	publish := &packet.Publish{
+		Header:  &packet.Header{Qos: int32(qos), Retain: true},
+		Topic:   tenancy.Topic(authContext.AccountID, input.ApplicationID, input.TopicName),
+		Payload: payload,
+	}
+	_, err = m.wasp.ScheduleMessage(ctx, &wasp.ScheduleMessageRequest{Message: publish})
+	if err != nil {
+		return nil, err
+	}
+	return &model.SetRetainedMessageOutput{
+		RetainedMessage: &wasp.RetainedMessage{
+			Publish:   publish,
+			LastAdded: time.Now().UnixNano(),
+		},
+		Success: true,
+	}, nil
+}
+
+func (m *mutationResolver) ClearRetainedMessage(ctx context.Context, applicationID string, topicName string) (string, error) {
+	authContext := auth.Informations(ctx)
+	_, err := m.vespiary.GetApplicationByAccountID(ctx, &vespiary.GetApplicationByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		Id:        applicationID,
+	})
+	if err != nil {
+		return "", err
+	}
+	err = topics.ValidateName(topicName)
+	if err != nil {
+		return "", err
+	}
+	_, err = m.wasp.DeleteRetainedMessage(ctx, &wasp.DeleteRetainedMessageRequest{
+		Topic: tenancy.Topic(authContext.AccountID, applicationID, topicName),
+	})
+	if err != nil {
+		return "", err
+	}
+	return topicName, nil
+}
@@ -0,0 +1,143 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/retention"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	"github.com/vx-labs/alveoli/alveoli/topics"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+func retentionPolicyToModel(policy retention.Policy) *model.RetentionPolicy {
+	out := &model.RetentionPolicy{
+		ApplicationID: policy.ApplicationID,
+		Pattern:       policy.Pattern,
+	}
+	if policy.RetentionSeconds > 0 {
+		v := int(policy.RetentionSeconds)
+		out.RetentionSeconds = &v
+	}
+	if policy.MaxSizeInBytes > 0 {
+		v := int(policy.MaxSizeInBytes)
+		out.MaxSizeInBytes = &v
+	}
+	return out
+}
+
+func (a *applicationResolver) RetentionPolicies(ctx context.Context, obj *vespiary.Application) ([]*model.RetentionPolicy, error) {
+	authContext := auth.Informations(ctx)
+	policies, err := retention.Load(ctx, a.store, authContext.AccountID, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*model.RetentionPolicy, len(policies))
+	for idx := range policies {
+		out[idx] = retentionPolicyToModel(policies[idx])
+	}
+	return out, nil
+}
+
+func (m *mutationResolver) SetRetentionPolicy(ctx context.Context, input model.SetRetentionPolicyInput) (*model.SetRetentionPolicyOutput, error) {
+	authContext := auth.Informations(ctx)
+	_, err := m.vespiary.GetApplicationByAccountID(ctx, &vespiary.GetApplicationByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		Id:        input.ApplicationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	policy := retention.Policy{
+		ApplicationID: input.ApplicationID,
+		Pattern:       "#",
+	}
+	if input.Pattern != nil {
+		err = topics.ValidateFilter(*input.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		policy.Pattern = *input.Pattern
+	}
+	if input.RetentionSeconds != nil {
+		if *input.RetentionSeconds <= 0 {
+			return nil, invalidInput("retentionSeconds must be positive")
+		}
+		policy.RetentionSeconds = int64(*input.RetentionSeconds)
+	}
+	if input.MaxSizeInBytes != nil {
+		if *input.MaxSizeInBytes <= 0 {
+			return nil, invalidInput("maxSizeInBytes must be positive")
+		}
+		policy.MaxSizeInBytes = int64(*input.MaxSizeInBytes)
+	}
+	if policy.RetentionSeconds == 0 && policy.MaxSizeInBytes == 0 {
+		return nil, invalidInput("retentionSeconds or maxSizeInBytes is required")
+	}
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	err = m.store.Put(ctx, authContext.AccountID, retention.StoreKind, retention.Key(policy.ApplicationID, policy.Pattern), data)
+	if err != nil {
+		return nil, err
+	}
+	return &model.SetRetentionPolicyOutput{
+		RetentionPolicy: retentionPolicyToModel(policy),
+		Success:         true,
+	}, nil
+}
+
+func (m *mutationResolver) DeleteRetentionPolicy(ctx context.Context, applicationID string, pattern *string) (string, error) {
+	authContext := auth.Informations(ctx)
+	key := "#"
+	if pattern != nil && *pattern != "" {
+		key = *pattern
+	}
+	err := m.store.Delete(ctx, authContext.AccountID, retention.StoreKind, retention.Key(applicationID, key))
+	if err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// retainedRecords returns the records matching pattern that are still covered by the application retention
+// policies, or by the default retention period.
+func (r *resolver) retainedRecords(ctx context.Context, accountID, applicationID string, pattern []byte) ([]*nest.Record, error) {
+	now := time.Now()
+	policies, err := retention.LoadEffective(ctx, r.store, r.nest, accountID, applicationID, now)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := r.nest.GetTopics(ctx, &nest.GetTopicsRequest{
+		Pattern:       pattern,
+		Watch:         false,
+		FromTimestamp: retention.Window(policies, now).UnixNano(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	prefix := tenancy.TopicPrefix(accountID, applicationID)
+	out := []*nest.Record{}
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return out, nil
+			}
+			return nil, err
+		}
+		for _, record := range msg.Records {
+			cutoff := retention.Cutoff(policies, strings.TrimPrefix(string(record.Topic), prefix), now)
+			if record.Timestamp >= cutoff.UnixNano() {
+				out = append(out, record)
+			}
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package resolvers
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/retention"
+	"github.com/vx-labs/alveoli/alveoli/search"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+const (
+	// searchScanBudget is the maximum number of records a single search can read from nest.
+	searchScanBudget   = 100000
+	defaultSearchLimit = 100
+	maxSearchLimit     = 1000
+)
+
+func (r *queryResolver) SearchRecords(ctx context.Context, applicationID string, query string, mode *model.SearchMode, pattern *string, from *time.Time, to *time.Time, limit *int) (*model.RecordSearchResult, error) {
+	authContext := auth.Informations(ctx)
+	_, err := r.vespiary.GetApplicationByAccountID(ctx, &vespiary.GetApplicationByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		Id:        applicationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	searchMode := search.Substring
+	if mode != nil {
+		searchMode = search.Mode(*mode)
+	}
+	matcher, err := search.Compile(searchMode, query)
+	if err != nil {
+		return nil, invalidInput("%v", err)
+	}
+	maxMatches := defaultSearchLimit
+	if limit != nil {
+		if *limit <= 0 || *limit > maxSearchLimit {
+			return nil, invalidInput("limit must be between 1 and %d", maxSearchLimit)
+		}
+		maxMatches = *limit
+	}
+	now := time.Now()
+	policies, err := retention.LoadEffective(ctx, r.store, r.nest, authContext.AccountID, applicationID, now)
+	if err != nil {
+		return nil, err
+	}
+	start := retention.Window(policies, now)
+	if from != nil {
+		start = *from
+	}
+	end := now
+	if to != nil {
+		end = *to
+	}
+	if !end.After(start) {
+		return nil, invalidInput("to must be after from")
+	}
+	topicPattern, err := userFilter(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := r.nest.GetTopics(ctx, &nest.GetTopicsRequest{
+		Pattern:       tenancy.TopicPattern(authContext.AccountID, applicationID, topicPattern),
+		Watch:         false,
+		FromTimestamp: start.UnixNano(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	prefix := tenancy.TopicPrefix(authContext.AccountID, applicationID)
+	out := &model.RecordSearchResult{Matches: []*model.RecordMatch{}}
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range msg.Records {
+			if out.ScannedRecords >= searchScanBudget || len(out.Matches) >= maxMatches {
+				out.Truncated = true
+				return out, nil
+			}
+			out.ScannedRecords++
+			// Nest sends the records stored after the first record not older than start, whatever their timestamp.
+			if record.Timestamp < start.UnixNano() || record.Timestamp >= end.UnixNano() {
+				continue
+			}
+			cutoff := retention.Cutoff(policies, strings.TrimPrefix(string(record.Topic), prefix), now)
+			if record.Timestamp < cutoff.UnixNano() {
+				continue
+			}
+			highlights, ok := matcher.Match(record.Payload)
+			if !ok {
+				continue
+			}
+			if highlights == nil {
+				highlights = []*search.Highlight{}
+			}
+			out.Matches = append(out.Matches, &model.RecordMatch{Record: record, Highlights: highlights})
+		}
+	}
+}
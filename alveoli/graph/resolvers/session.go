@@ -2,13 +2,14 @@ package resolvers
 
 import (
 	"context"
-	"errors"
-	"strings"
 	"time"
 
 	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
 	vespiary "github.com/vx-labs/vespiary/vespiary/api"
 	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type sessionResolver struct {
@@ -16,33 +17,36 @@ type sessionResolver struct {
 }
 
 func (s *sessionResolver) ID(ctx context.Context, obj *wasp.SessionMetadatas) (string, error) {
-	tokens := strings.SplitN(obj.SessionID, "/", 2)
-	if len(tokens) != 2 {
-		return "", errors.New("failed to find id in session id")
+	_, _, id, err := tenancy.ParseSessionID(obj.SessionID)
+	if err != nil {
+		return "", err
 	}
-	return tokens[1], nil
+	return id, nil
 }
 
 func (s *sessionResolver) ClientID(ctx context.Context, obj *wasp.SessionMetadatas) (string, error) {
 	return string(obj.ClientID), nil
 }
 func (s *sessionResolver) ApplicationID(ctx context.Context, obj *wasp.SessionMetadatas) (string, error) {
-	tokens := strings.SplitN(obj.MountPoint, "/", 3)
-	if len(tokens) != 3 {
-		return "", errors.New("failed to find applicationeId in session id")
+	accountID, applicationID, err := tenancy.ParseMountPoint(obj.MountPoint)
+	if err != nil {
+		return "", err
+	}
+	if accountID != auth.Informations(ctx).AccountID {
+		return "", tenancy.ErrForeignTenant
 	}
-	return tokens[2], nil
+	return applicationID, nil
 }
 func (s *sessionResolver) ConnectedAt(ctx context.Context, obj *wasp.SessionMetadatas) (*time.Time, error) {
 	t := time.Unix(0, obj.ConnectedAt)
 	return &t, nil
 }
 func (s *sessionResolver) ApplicationProfileID(ctx context.Context, obj *wasp.SessionMetadatas) (string, error) {
-	tokens := strings.SplitN(obj.SessionID, "/", 2)
-	if len(tokens) != 2 {
-		return "", errors.New("failed to find applicationProfileId in session id")
+	profile, err := s.ApplicationProfile(ctx, obj)
+	if err != nil {
+		return "", err
 	}
-	return tokens[0], nil
+	return profile.ID, nil
 }
 func (a *sessionResolver) Application(ctx context.Context, obj *wasp.SessionMetadatas) (*vespiary.Application, error) {
 	authContext := auth.Informations(ctx)
@@ -59,18 +63,30 @@ func (a *sessionResolver) Application(ctx context.Context, obj *wasp.SessionMeta
 	}
 	return out.Application, nil
 }
+
+// ApplicationProfile returns the profile the session authenticated with. Vespiary session IDs only carry the profile
+// name, which is unique inside the session application.
 func (a *sessionResolver) ApplicationProfile(ctx context.Context, obj *wasp.SessionMetadatas) (*vespiary.ApplicationProfile, error) {
 	authContext := auth.Informations(ctx)
-	id, err := a.ApplicationProfileID(ctx, obj)
+	applicationID, err := a.ApplicationID(ctx, obj)
 	if err != nil {
 		return nil, err
 	}
-	out, err := a.vespiary.GetApplicationProfileByAccountID(ctx, &vespiary.GetApplicationProfileByAccountIDRequest{
-		AccountID: authContext.AccountID,
-		ID:        id,
+	_, name, _, err := tenancy.ParseSessionID(obj.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	out, err := a.vespiary.ListApplicationProfilesByApplication(ctx, &vespiary.ListApplicationProfilesByApplicationRequest{
+		AccountID:     authContext.AccountID,
+		ApplicationID: applicationID,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return out.ApplicationProfile, nil
+	for _, profile := range out.ApplicationProfiles {
+		if profile.Name == name {
+			return profile, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "application profile not found")
 }
@@ -0,0 +1,189 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/decoding"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/jsonpath"
+	"github.com/vx-labs/alveoli/alveoli/retention"
+	"github.com/vx-labs/alveoli/alveoli/stats"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	defaultStatsBucket      = time.Hour
+	defaultStatsBucketCount = 24
+)
+
+func (a *applicationResolver) Stats(ctx context.Context, obj *vespiary.Application, from *time.Time, to *time.Time, bucket *int) ([]*stats.Bucket, error) {
+	authContext := auth.Informations(ctx)
+	pattern := tenancy.TopicPattern(authContext.AccountID, obj.ID, "#")
+	return a.stats(ctx, authContext.AccountID, obj.ID, pattern, from, to, bucket)
+}
+
+func (t *topicResolver) Stats(ctx context.Context, obj *nest.TopicMetadata, from *time.Time, to *time.Time, bucket *int) ([]*stats.Bucket, error) {
+	applicationID, err := t.ApplicationID(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	return t.stats(ctx, auth.Informations(ctx).AccountID, applicationID, obj.Name, from, to, bucket)
+}
+
+// statsRange returns the time range and bucket width requested by the user.
+// When omitted, the time range covers the last 24 buckets, and ends at the end of the current bucket
+// so results can be cached.
+func statsRange(from *time.Time, to *time.Time, bucket *int) (time.Time, time.Time, time.Duration) {
+	width := defaultStatsBucket
+	if bucket != nil {
+		width = time.Duration(*bucket) * time.Second
+	}
+	var end time.Time
+	if to != nil {
+		end = *to
+	} else if width > 0 {
+		end = time.Now().Truncate(width).Add(width)
+	}
+	start := end.Add(-defaultStatsBucketCount * width)
+	if from != nil {
+		start = *from
+	}
+	return start, end, width
+}
+
+// retainedStart returns the timestamp statistics must be read from, so that records
+// no longer covered by the application retention policies are not fetched from nest.
+func retainedStart(policies []retention.Policy, start time.Time, now time.Time) time.Time {
+	if window := retention.Window(policies, now); window.After(start) {
+		return window
+	}
+	return start
+}
+
+// stats computes the statistics of the records matching pattern, using buckets of bucket seconds.
+// Records no longer covered by the application retention policies are ignored.
+func (r *resolver) stats(ctx context.Context, accountID, applicationID string, pattern []byte, from *time.Time, to *time.Time, bucket *int) ([]*stats.Bucket, error) {
+	start, end, width := statsRange(from, to, bucket)
+	aggregator, err := stats.NewAggregator(start, end, width)
+	if err != nil {
+		return nil, invalidInput("%v", err)
+	}
+	cacheKey := fmt.Sprintf("stats/%s/%d/%d/%d", pattern, start.UnixNano(), end.UnixNano(), width)
+	if value, ok := r.statistics.get(cacheKey); ok {
+		return value.([]*stats.Bucket), nil
+	}
+	now := time.Now()
+	policies, err := retention.LoadEffective(ctx, r.store, r.nest, accountID, applicationID, now)
+	if err != nil {
+		return nil, err
+	}
+	prefix := tenancy.TopicPrefix(accountID, applicationID)
+	stream, err := r.nest.GetTopics(ctx, &nest.GetTopicsRequest{
+		Pattern:       pattern,
+		Watch:         false,
+		FromTimestamp: retainedStart(policies, start, now).UnixNano(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range msg.Records {
+			if !retention.Retained(policies, strings.TrimPrefix(string(record.Topic), prefix), record.Timestamp, now) {
+				continue
+			}
+			aggregator.Add(record.Timestamp, record.Sender, len(record.Payload))
+		}
+	}
+	out := aggregator.Buckets()
+	r.statistics.set(cacheKey, out)
+	return out, nil
+}
+
+func (t *topicResolver) Series(ctx context.Context, obj *nest.TopicMetadata, path string, from *time.Time, to *time.Time, bucket *int, aggregate *model.SeriesAggregate) ([]*stats.Point, error) {
+	authContext := auth.Informations(ctx)
+	applicationID, err := t.ApplicationID(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	topicName, err := t.Name(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	jsonPath, err := jsonpath.Parse(path)
+	if err != nil {
+		return nil, invalidInput("%v", err)
+	}
+	function := stats.Avg
+	if aggregate != nil {
+		function = stats.Aggregate(*aggregate)
+	}
+	start, end, width := statsRange(from, to, bucket)
+	aggregator, err := stats.NewSeriesAggregator(start, end, width, function)
+	if err != nil {
+		return nil, invalidInput("%v", err)
+	}
+	cacheKey := fmt.Sprintf("series/%s/%d/%d/%d/%s/%s", obj.Name, start.UnixNano(), end.UnixNano(), width, function, path)
+	if value, ok := t.statistics.get(cacheKey); ok {
+		return value.([]*stats.Point), nil
+	}
+	var message protoreflect.MessageDescriptor
+	validator, err := t.topicValidator(ctx, authContext.AccountID, applicationID, topicName)
+	if err != nil {
+		return nil, err
+	}
+	if validator != nil {
+		message = validator.Message()
+	}
+	now := time.Now()
+	policies, err := retention.LoadEffective(ctx, t.store, t.nest, authContext.AccountID, applicationID, now)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := t.nest.GetTopics(ctx, &nest.GetTopicsRequest{
+		Pattern:       obj.Name,
+		Watch:         false,
+		FromTimestamp: retainedStart(policies, start, now).UnixNano(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range msg.Records {
+			if !retention.Retained(policies, topicName, record.Timestamp, now) {
+				continue
+			}
+			decoded, _, err := decoding.Detect(record.Payload, message)
+			if err != nil {
+				continue
+			}
+			if value, ok := jsonPath.Number(decoded); ok {
+				aggregator.Add(record.Timestamp, value)
+			}
+		}
+	}
+	out := aggregator.Points()
+	t.statistics.set(cacheKey, out)
+	return out, nil
+}
@@ -2,33 +2,43 @@ package resolvers
 
 import (
 	"context"
-	"errors"
-	"io"
-	"strings"
-	"time"
 
 	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	"github.com/vx-labs/alveoli/alveoli/topics"
 	nest "github.com/vx-labs/nest/nest/api"
 	vespiary "github.com/vx-labs/vespiary/vespiary/api"
 )
 
+// userFilter returns the validated topic filter provided by the user, or "#" when it is omitted.
+func userFilter(pattern *string) (string, error) {
+	if pattern == nil {
+		return "#", nil
+	}
+	err := topics.ValidateFilter(*pattern)
+	if err != nil {
+		return "", err
+	}
+	return *pattern, nil
+}
+
 type topicResolver struct {
 	*resolver
 }
 
 func (r *topicResolver) Name(ctx context.Context, obj *nest.TopicMetadata) (string, error) {
-	tokens := strings.SplitN(string(obj.Name), "/", 4)
-	if len(tokens) != 4 {
-		return "", errors.New("failed to extract name from topic")
+	topic, err := tenancy.ParseOwnedTopic(auth.Informations(ctx).AccountID, obj.Name)
+	if err != nil {
+		return "", err
 	}
-	return tokens[3], nil
+	return topic.Name, nil
 }
 func (r *topicResolver) ApplicationID(ctx context.Context, obj *nest.TopicMetadata) (string, error) {
-	tokens := strings.SplitN(string(obj.Name), "/", 4)
-	if len(tokens) != 4 {
-		return "", errors.New("failed to extract name from topic")
+	topic, err := tenancy.ParseOwnedTopic(auth.Informations(ctx).AccountID, obj.Name)
+	if err != nil {
+		return "", err
 	}
-	return tokens[2], nil
+	return topic.ApplicationID, nil
 }
 func (a *topicResolver) Application(ctx context.Context, obj *nest.TopicMetadata) (*vespiary.Application, error) {
 	authContext := auth.Informations(ctx)
@@ -56,23 +66,10 @@ func (r *topicResolver) LastRecord(ctx context.Context, obj *nest.TopicMetadata)
 	return obj.LastRecord, nil
 }
 func (r *topicResolver) Records(ctx context.Context, obj *nest.TopicMetadata) ([]*nest.Record, error) {
-	stream, err := r.nest.GetTopics(ctx, &nest.GetTopicsRequest{
-		Pattern:       obj.Name,
-		Watch:         false,
-		FromTimestamp: time.Now().Add(-15 * 24 * time.Hour).UnixNano(),
-	})
+	authContext := auth.Informations(ctx)
+	applicationID, err := r.ApplicationID(ctx, obj)
 	if err != nil {
 		return nil, err
 	}
-	out := []*nest.Record{}
-	for {
-		msg, err := stream.Recv()
-		if err != nil {
-			if err == io.EOF {
-				return out, nil
-			}
-			return nil, err
-		}
-		out = append(out, msg.Records...)
-	}
+	return r.retainedRecords(ctx, authContext.AccountID, applicationID, obj.Name)
 }
@@ -0,0 +1,207 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/schemas"
+	"github.com/vx-labs/alveoli/alveoli/topics"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+const topicSchemasStoreKind = "topic-schemas"
+
+func topicSchemaKey(applicationID, pattern string) string {
+	return applicationID + "/" + pattern
+}
+
+func topicSchemaToModel(schema schemas.Schema) *model.TopicSchema {
+	out := &model.TopicSchema{
+		ApplicationID: schema.ApplicationID,
+		Pattern:       schema.Pattern,
+		Type:          model.TopicSchemaType(schema.Type),
+	}
+	if schema.JSONSchema != "" {
+		out.JSONSchema = &schema.JSONSchema
+	}
+	if schema.MessageType != "" {
+		out.MessageType = &schema.MessageType
+	}
+	return out
+}
+
+// topicSchemas returns the schemas attached to the topics of the given application, sorted by pattern.
+func (r *resolver) topicSchemas(ctx context.Context, accountID, applicationID string) ([]schemas.Schema, error) {
+	values, err := r.store.List(ctx, accountID, topicSchemasStoreKind)
+	if err != nil {
+		return nil, err
+	}
+	out := []schemas.Schema{}
+	for _, value := range values {
+		schema := schemas.Schema{}
+		err := json.Unmarshal(value, &schema)
+		if err != nil {
+			return nil, err
+		}
+		if schema.ApplicationID == applicationID {
+			out = append(out, schema)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Pattern < out[j].Pattern })
+	return out, nil
+}
+
+// topicValidators returns the compiled schemas of the given application.
+// Schemas that can no longer be compiled, because their message type was removed from the application
+// descriptor set for example, are ignored.
+func (r *resolver) topicValidators(ctx context.Context, accountID, applicationID string) ([]*schemas.Validator, error) {
+	cacheKey := accountID + "/" + applicationID
+	if value, ok := r.validators.get(cacheKey); ok {
+		return value.([]*schemas.Validator), nil
+	}
+	topicSchemas, err := r.topicSchemas(ctx, accountID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	files, err := r.protobufDescriptors(ctx, accountID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*schemas.Validator, 0, len(topicSchemas))
+	for _, schema := range topicSchemas {
+		validator, err := schemas.Compile(schema, files)
+		if err != nil {
+			continue
+		}
+		out = append(out, validator)
+	}
+	r.validators.set(cacheKey, out)
+	return out, nil
+}
+
+// topicValidator returns the validator of the schema matching the given topic name, or nil.
+func (r *resolver) topicValidator(ctx context.Context, accountID, applicationID, topicName string) (*schemas.Validator, error) {
+	validators, err := r.topicValidators(ctx, accountID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	return schemas.Find(validators, topicName), nil
+}
+
+// validatePayload checks payload against the schema attached to topicName, if any.
+// It must be called by all publish paths before handing messages to the broker.
+func (r *resolver) validatePayload(ctx context.Context, accountID, applicationID, topicName string, payload []byte) error {
+	validator, err := r.topicValidator(ctx, accountID, applicationID, topicName)
+	if err != nil {
+		return err
+	}
+	if validator == nil {
+		return nil
+	}
+	validationErrors := validator.Validate(payload)
+	if len(validationErrors) > 0 {
+		return invalidInput("payload does not match the schema of %q: %s", validator.Schema.Pattern, strings.Join(validationErrors, "; "))
+	}
+	return nil
+}
+
+func (a *applicationResolver) TopicSchemas(ctx context.Context, obj *vespiary.Application) ([]*model.TopicSchema, error) {
+	authContext := auth.Informations(ctx)
+	topicSchemas, err := a.topicSchemas(ctx, authContext.AccountID, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*model.TopicSchema, len(topicSchemas))
+	for idx := range topicSchemas {
+		out[idx] = topicSchemaToModel(topicSchemas[idx])
+	}
+	return out, nil
+}
+
+func (r *recordResolver) ValidationErrors(ctx context.Context, obj *nest.Record) ([]string, error) {
+	authContext := auth.Informations(ctx)
+	applicationID, err := r.ApplicationID(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	topicName, err := r.TopicName(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	validator, err := r.topicValidator(ctx, authContext.AccountID, applicationID, topicName)
+	if err != nil {
+		return nil, err
+	}
+	if validator == nil {
+		return nil, nil
+	}
+	return validator.Validate(obj.Payload), nil
+}
+
+func (m *mutationResolver) SetTopicSchema(ctx context.Context, input model.SetTopicSchemaInput) (*model.SetTopicSchemaOutput, error) {
+	authContext := auth.Informations(ctx)
+	_, err := m.vespiary.GetApplicationByAccountID(ctx, &vespiary.GetApplicationByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		Id:        input.ApplicationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = topics.ValidateFilter(input.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	schema := schemas.Schema{
+		ApplicationID: input.ApplicationID,
+		Pattern:       input.Pattern,
+		Type:          schemas.Type(input.Type),
+	}
+	switch input.Type {
+	case model.TopicSchemaTypeJSONSchema:
+		if input.JSONSchema == nil {
+			return nil, invalidInput("jsonSchema is required for JSON_SCHEMA schemas")
+		}
+		schema.JSONSchema = *input.JSONSchema
+	case model.TopicSchemaTypeProtobuf:
+		if input.MessageType == nil {
+			return nil, invalidInput("messageType is required for PROTOBUF schemas")
+		}
+		schema.MessageType = *input.MessageType
+	}
+	files, err := m.protobufDescriptors(ctx, authContext.AccountID, input.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = schemas.Compile(schema, files)
+	if err != nil {
+		return nil, invalidInput("%v", err)
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	err = m.store.Put(ctx, authContext.AccountID, topicSchemasStoreKind, topicSchemaKey(input.ApplicationID, input.Pattern), data)
+	if err != nil {
+		return nil, err
+	}
+	m.validators.invalidate(authContext.AccountID + "/" + input.ApplicationID)
+	return &model.SetTopicSchemaOutput{
+		TopicSchema: topicSchemaToModel(schema),
+		Success:     true,
+	}, nil
+}
+
+func (m *mutationResolver) DeleteTopicSchema(ctx context.Context, applicationID string, pattern string) (string, error) {
+	authContext := auth.Informations(ctx)
+	err := m.store.Delete(ctx, authContext.AccountID, topicSchemasStoreKind, topicSchemaKey(applicationID, pattern))
+	if err != nil {
+		return "", err
+	}
+	m.validators.invalidate(authContext.AccountID + "/" + applicationID)
+	return pattern, nil
+}
@@ -0,0 +1,129 @@
+package resolvers
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	"github.com/vx-labs/alveoli/alveoli/topics"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+const defaultTopicTreeDepth = 1
+
+type topicTreeBuilder struct {
+	node     *model.TopicTreeNode
+	children map[string]*topicTreeBuilder
+}
+
+func newTopicTreeBuilder(name, path string) *topicTreeBuilder {
+	return &topicTreeBuilder{
+		node:     &model.TopicTreeNode{Name: name, Path: path, Children: []*model.TopicTreeNode{}},
+		children: map[string]*topicTreeBuilder{},
+	}
+}
+
+func (b *topicTreeBuilder) add(levels []string, metadata *nest.TopicMetadata) {
+	b.node.MessageCount += int(metadata.MessageCount)
+	b.node.SizeInBytes += int(metadata.SizeInBytes)
+	if metadata.LastRecord != nil {
+		lastActivity := time.Unix(0, metadata.LastRecord.Timestamp)
+		if b.node.LastActivity == nil || lastActivity.After(*b.node.LastActivity) {
+			b.node.LastActivity = &lastActivity
+		}
+	}
+	if len(levels) == 0 {
+		b.node.Topic = metadata
+		return
+	}
+	child, ok := b.children[levels[0]]
+	if !ok {
+		path := levels[0]
+		if b.node.Path != "" {
+			path = b.node.Path + "/" + levels[0]
+		}
+		child = newTopicTreeBuilder(levels[0], path)
+		b.children[levels[0]] = child
+	}
+	child.add(levels[1:], metadata)
+}
+
+// build returns the tree node, including depth levels of children.
+func (b *topicTreeBuilder) build(depth int) *model.TopicTreeNode {
+	b.node.ChildCount = len(b.children)
+	if depth <= 0 {
+		return b.node
+	}
+	names := make([]string, 0, len(b.children))
+	for name := range b.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.node.Children = append(b.node.Children, b.children[name].build(depth-1))
+	}
+	return b.node
+}
+
+func (r *queryResolver) TopicTree(ctx context.Context, applicationID string, prefix *string, depth *int) (*model.TopicTreeNode, error) {
+	authContext := auth.Informations(ctx)
+	_, err := r.vespiary.GetApplicationByAccountID(ctx, &vespiary.GetApplicationByAccountIDRequest{
+		AccountID: authContext.AccountID,
+		Id:        applicationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	levels := defaultTopicTreeDepth
+	if depth != nil {
+		if *depth < 0 {
+			return nil, invalidInput("depth must not be negative")
+		}
+		levels = *depth
+	}
+	rootPath := ""
+	if prefix != nil {
+		rootPath = strings.Trim(*prefix, "/")
+	}
+	applicationPrefix := tenancy.TopicPrefix(authContext.AccountID, applicationID)
+	pattern := applicationPrefix + "#"
+	if rootPath != "" {
+		err := topics.ValidateName(rootPath)
+		if err != nil {
+			return nil, err
+		}
+		pattern = applicationPrefix + rootPath + "/#"
+	}
+	out, err := r.nest.ListTopics(ctx, &nest.ListTopicsRequest{
+		Pattern: []byte(pattern),
+	})
+	if err != nil {
+		return nil, err
+	}
+	rootName := rootPath
+	if idx := strings.LastIndexByte(rootPath, '/'); idx >= 0 {
+		rootName = rootPath[idx+1:]
+	}
+	root := newTopicTreeBuilder(rootName, rootPath)
+	for _, metadata := range out.TopicMetadatas {
+		name := strings.TrimPrefix(string(metadata.Name), applicationPrefix)
+		var relative []string
+		switch {
+		case rootPath == "":
+			relative = strings.Split(name, "/")
+		case name == rootPath:
+			relative = []string{}
+		case strings.HasPrefix(name, rootPath+"/"):
+			relative = strings.Split(strings.TrimPrefix(name, rootPath+"/"), "/")
+		default:
+			continue
+		}
+		root.add(relative, metadata)
+	}
+	return root.build(levels), nil
+}
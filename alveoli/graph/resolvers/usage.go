@@ -0,0 +1,243 @@
+package resolvers
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/usage"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+)
+
+type accountResolver struct {
+	*resolver
+}
+
+func (a *accountResolver) Usage(ctx context.Context, obj *vespiary.Account) (*usage.Scope, error) {
+	authContext := auth.Informations(ctx)
+	return &usage.Scope{AccountID: authContext.AccountID}, nil
+}
+func (a *accountResolver) Quotas(ctx context.Context, obj *vespiary.Account) (*model.Quotas, error) {
+	quotas := a.quotas.For(auth.Informations(ctx).AccountID)
+	optional := func(v int) *int {
+		if v <= 0 {
+			return nil
+		}
+		return &v
+	}
+	return &model.Quotas{
+		MaxApplications:        optional(quotas.MaxApplications),
+		MaxApplicationProfiles: optional(quotas.MaxApplicationProfiles),
+		MaxStoredBytes:         optional(quotas.MaxStoredBytes),
+	}, nil
+}
+
+func (a *applicationResolver) Usage(ctx context.Context, obj *vespiary.Application) (*usage.Scope, error) {
+	authContext := auth.Informations(ctx)
+	return &usage.Scope{AccountID: authContext.AccountID, ApplicationID: obj.ID}, nil
+}
+
+type usageResolver struct {
+	*resolver
+}
+
+func (u *usageResolver) ConnectedSessions(ctx context.Context, obj *usage.Scope) (int, error) {
+	out, err := u.wasp.ListSessionMetadatas(ctx, &wasp.ListSessionMetadatasRequest{})
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, sessionMetadatas := range out.SessionMetadatasList {
+		if obj.Contains(sessionMetadatas.MountPoint) {
+			count++
+		}
+	}
+	return count, nil
+}
+func (u *usageResolver) ApplicationCount(ctx context.Context, obj *usage.Scope) (*int, error) {
+	if obj.ApplicationID != "" {
+		return nil, nil
+	}
+	count, err := u.applicationCount(ctx, obj.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return &count, nil
+}
+func (u *usageResolver) ApplicationProfileCount(ctx context.Context, obj *usage.Scope) (int, error) {
+	return u.applicationProfileCount(ctx, *obj)
+}
+func (u *usageResolver) TopicCount(ctx context.Context, obj *usage.Scope) (int, error) {
+	topics, err := u.scopeTopics(ctx, *obj)
+	if err != nil {
+		return 0, err
+	}
+	return len(topics), nil
+}
+func (u *usageResolver) StoredMessages(ctx context.Context, obj *usage.Scope) (int, error) {
+	topics, err := u.scopeTopics(ctx, *obj)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, metadata := range topics {
+		count += int(metadata.MessageCount)
+	}
+	return count, nil
+}
+func (u *usageResolver) StoredBytes(ctx context.Context, obj *usage.Scope) (int, error) {
+	return u.storedBytes(ctx, *obj)
+}
+func (u *usageResolver) Messages(ctx context.Context, obj *usage.Scope, since time.Time) (int, error) {
+	applications, err := u.scopeApplications(ctx, *obj)
+	if err != nil {
+		return 0, err
+	}
+	stream, err := u.nest.GetTopics(ctx, &nest.GetTopicsRequest{
+		Pattern:       obj.TopicPattern(),
+		Watch:         false,
+		FromTimestamp: since.UnixNano(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return count, nil
+			}
+			return 0, err
+		}
+		for _, record := range msg.Records {
+			if record.Timestamp < since.UnixNano() {
+				continue
+			}
+			if applications == nil || belongsToApplications(applications, record.Topic) {
+				count++
+			}
+		}
+	}
+}
+
+// scopeApplications returns the existing applications of an account scope, or nil for application scopes.
+func (r *resolver) scopeApplications(ctx context.Context, scope usage.Scope) (map[string]struct{}, error) {
+	if scope.ApplicationID != "" {
+		return nil, nil
+	}
+	return r.existingApplications(ctx, scope.AccountID)
+}
+
+// scopeTopics returns the topics of the scope. Topics left behind by deleted applications are not part
+// of account scopes.
+func (r *resolver) scopeTopics(ctx context.Context, scope usage.Scope) ([]*nest.TopicMetadata, error) {
+	applications, err := r.scopeApplications(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	out, err := r.nest.ListTopics(ctx, &nest.ListTopicsRequest{Pattern: scope.TopicPattern()})
+	if err != nil {
+		return nil, err
+	}
+	if applications == nil {
+		return out.TopicMetadatas, nil
+	}
+	topics := make([]*nest.TopicMetadata, 0, len(out.TopicMetadatas))
+	for _, metadata := range out.TopicMetadatas {
+		if belongsToApplications(applications, metadata.Name) {
+			topics = append(topics, metadata)
+		}
+	}
+	return topics, nil
+}
+
+func (r *resolver) applicationCount(ctx context.Context, accountID string) (int, error) {
+	out, err := r.vespiary.ListApplicationsByAccountID(ctx, &vespiary.ListApplicationsByAccountIDRequest{
+		AccountID: accountID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(out.Applications), nil
+}
+
+func (r *resolver) applicationProfileCount(ctx context.Context, scope usage.Scope) (int, error) {
+	if scope.ApplicationID == "" {
+		out, err := r.vespiary.ListApplicationProfilesByAccountID(ctx, &vespiary.ListApplicationProfilesByAccountIDRequest{
+			AccountID: scope.AccountID,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return len(out.ApplicationProfiles), nil
+	}
+	out, err := r.vespiary.ListApplicationProfilesByApplication(ctx, &vespiary.ListApplicationProfilesByApplicationRequest{
+		AccountID:     scope.AccountID,
+		ApplicationID: scope.ApplicationID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(out.ApplicationProfiles), nil
+}
+
+func (r *resolver) storedBytes(ctx context.Context, scope usage.Scope) (int, error) {
+	topics, err := r.scopeTopics(ctx, scope)
+	if err != nil {
+		return 0, err
+	}
+	size := 0
+	for _, metadata := range topics {
+		size += int(metadata.SizeInBytes)
+	}
+	return size, nil
+}
+
+// checkApplicationQuotas returns an error if the account cannot create a new application.
+func (r *resolver) checkApplicationQuotas(ctx context.Context, accountID string) error {
+	quotas := r.quotas.For(accountID)
+	if quotas.MaxApplications > 0 {
+		count, err := r.applicationCount(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if err := quotas.CheckApplications(count); err != nil {
+			return err
+		}
+	}
+	return r.checkStorageQuota(ctx, accountID, quotas)
+}
+
+// checkApplicationProfileQuotas returns an error if the application cannot have a new profile.
+func (r *resolver) checkApplicationProfileQuotas(ctx context.Context, accountID, applicationID string) error {
+	quotas := r.quotas.For(accountID)
+	if quotas.MaxApplicationProfiles > 0 {
+		count, err := r.applicationProfileCount(ctx, usage.Scope{AccountID: accountID, ApplicationID: applicationID})
+		if err != nil {
+			return err
+		}
+		if err := quotas.CheckApplicationProfiles(count); err != nil {
+			return err
+		}
+	}
+	return r.checkStorageQuota(ctx, accountID, quotas)
+}
+
+// checkStorageQuota returns an error if the applications of the account store more data than allowed.
+// Topics left behind by deleted applications are not counted: nest cannot delete them, so counting them
+// would prevent the account from ever getting back under its quota.
+func (r *resolver) checkStorageQuota(ctx context.Context, accountID string, quotas usage.Quotas) error {
+	if quotas.MaxStoredBytes <= 0 {
+		return nil
+	}
+	size, err := r.storedBytes(ctx, usage.Scope{AccountID: accountID})
+	if err != nil {
+		return err
+	}
+	return quotas.CheckStoredBytes(size)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+func registerApplicationProfiles(router *httprouter.Router, authProvider auth.Provider, root generated.ResolverRoot) {
+	applicationProfileHandler := &applicationProfiles{root: root}
+	router.Handler(http.MethodGet, "/application-profiles", authenticated(authProvider, applicationProfileHandler.List))
+	router.Handler(http.MethodPost, "/application-profiles", authenticated(authProvider, applicationProfileHandler.Create))
+	router.Handler(http.MethodGet, "/application-profiles/:id", authenticated(authProvider, applicationProfileHandler.Get))
+	router.Handler(http.MethodDelete, "/application-profiles/:id", authenticated(authProvider, applicationProfileHandler.Delete))
+}
+
+type applicationProfiles struct {
+	root generated.ResolverRoot
+}
+
+// CreateApplicationProfileInput is the body expected when creating an application profile. A password is generated
+// when Password is empty.
+type CreateApplicationProfileInput struct {
+	Name          string `json:"name"`
+	ApplicationID string `json:"applicationId"`
+	Password      string `json:"password"`
+}
+
+func (d *applicationProfiles) List(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	list, err := d.root.Query().ApplicationProfiles(r.Context())
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	out := make([]ApplicationProfile, len(list))
+	for idx := range list {
+		out[idx], err = applicationProfileFromResolver(r.Context(), d.root, list[idx])
+		if err != nil {
+			writeRPCError(w, err)
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (d *applicationProfiles) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	applicationProfile, err := d.root.Query().ApplicationProfile(r.Context(), ps.ByName("id"))
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	if applicationProfile == nil {
+		writeError(w, http.StatusNotFound, "application profile not found")
+		return
+	}
+	out, err := applicationProfileFromResolver(r.Context(), d.root, applicationProfile)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (d *applicationProfiles) Create(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	input := CreateApplicationProfileInput{}
+	if !decodeBody(w, r, &input) {
+		return
+	}
+	if input.Name == "" || input.ApplicationID == "" {
+		writeError(w, http.StatusBadRequest, "name and applicationId are required")
+		return
+	}
+	created, err := d.root.Mutation().CreateApplicationProfile(r.Context(), vespiary.CreateApplicationProfileRequest{
+		Name:          input.Name,
+		ApplicationID: input.ApplicationID,
+		Password:      input.Password,
+	})
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	profile, err := applicationProfileFromResolver(r.Context(), d.root, created.ApplicationProfile)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	connection, err := deviceConnectionFromResolver(r.Context(), d.root, created.ApplicationProfile)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	out := CreatedApplicationProfile{ApplicationProfile: profile, Connection: connection}
+	if created.GeneratedPassword != nil {
+		out.GeneratedPassword = *created.GeneratedPassword
+	}
+	writeJSON(w, http.StatusCreated, out)
+}
+
+func (d *applicationProfiles) Delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	_, err := d.root.Mutation().DeleteApplicationProfile(r.Context(), ps.ByName("id"))
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+func registerApplications(router *httprouter.Router, authProvider auth.Provider, root generated.ResolverRoot) {
+	applicationHandler := &applications{root: root}
+	router.Handler(http.MethodGet, "/applications", authenticated(authProvider, applicationHandler.List))
+	router.Handler(http.MethodPost, "/applications", authenticated(authProvider, applicationHandler.Create))
+	router.Handler(http.MethodGet, "/applications/:id", authenticated(authProvider, applicationHandler.Get))
+	router.Handler(http.MethodDelete, "/applications/:id", authenticated(authProvider, applicationHandler.Delete))
+	router.Handler(http.MethodGet, "/applications/:id/profiles", authenticated(authProvider, applicationHandler.Profiles))
+	router.Handler(http.MethodGet, "/applications/:id/topics", authenticated(authProvider, applicationHandler.Topics))
+	router.Handler(http.MethodGet, "/applications/:id/topics/*name", authenticated(authProvider, applicationHandler.Topic))
+	router.Handler(http.MethodGet, "/applications/:id/records", authenticated(authProvider, applicationHandler.Records))
+}
+
+type applications struct {
+	root generated.ResolverRoot
+}
+
+// CreateApplicationInput is the body expected when creating an application.
+type CreateApplicationInput struct {
+	Name string `json:"name"`
+}
+
+// application resolves the application referenced by the "id" route parameter, and writes
+// an error response if it cannot be found.
+func (d *applications) application(w http.ResponseWriter, r *http.Request, ps httprouter.Params) *vespiary.Application {
+	application, err := d.root.Query().Application(r.Context(), ps.ByName("id"))
+	if err != nil {
+		writeRPCError(w, err)
+		return nil
+	}
+	if application == nil {
+		writeError(w, http.StatusNotFound, "application not found")
+		return nil
+	}
+	return application
+}
+
+func (d *applications) List(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	list, err := d.root.Query().Applications(r.Context())
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	out := make([]Application, len(list))
+	for idx := range list {
+		out[idx], err = applicationFromResolver(r.Context(), d.root, list[idx])
+		if err != nil {
+			writeRPCError(w, err)
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (d *applications) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	application := d.application(w, r, ps)
+	if application == nil {
+		return
+	}
+	out, err := applicationFromResolver(r.Context(), d.root, application)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (d *applications) Create(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	input := CreateApplicationInput{}
+	if !decodeBody(w, r, &input) {
+		return
+	}
+	if input.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+	created, err := d.root.Mutation().CreateApplication(r.Context(), vespiary.CreateApplicationRequest{
+		Name: input.Name,
+	})
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	out, err := applicationFromResolver(r.Context(), d.root, created.Application)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, out)
+}
+
+func (d *applications) Delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var force bool
+	if value := optionalQueryParameter(r, "force"); value != nil {
+		var err error
+		force, err = strconv.ParseBool(*value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "force must be a boolean")
+			return
+		}
+	}
+	_, err := d.root.Mutation().DeleteApplication(r.Context(), ps.ByName("id"), &force)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (d *applications) Profiles(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	application := d.application(w, r, ps)
+	if application == nil {
+		return
+	}
+	list, err := d.root.Application().Profiles(r.Context(), application)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	out := make([]ApplicationProfile, len(list))
+	for idx := range list {
+		out[idx], err = applicationProfileFromResolver(r.Context(), d.root, list[idx])
+		if err != nil {
+			writeRPCError(w, err)
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (d *applications) Topics(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	application := d.application(w, r, ps)
+	if application == nil {
+		return
+	}
+	list, err := d.root.Application().Topics(r.Context(), application, optionalQueryParameter(r, "pattern"))
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	out := make([]Topic, len(list))
+	for idx := range list {
+		out[idx], err = topicFromResolver(r.Context(), d.root, list[idx])
+		if err != nil {
+			writeRPCError(w, err)
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (d *applications) Topic(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	application := d.application(w, r, ps)
+	if application == nil {
+		return
+	}
+	name := ps.ByName("name")[1:]
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "topic name is required")
+		return
+	}
+	list, err := d.root.Application().Topics(r.Context(), application, &name)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	for _, topic := range list {
+		out, err := topicFromResolver(r.Context(), d.root, topic)
+		if err != nil {
+			writeRPCError(w, err)
+			return
+		}
+		if out.Name == name {
+			writeJSON(w, http.StatusOK, out)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "topic not found")
+}
+
+func (d *applications) Records(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	encoding, err := payloadEncodingParameter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	application := d.application(w, r, ps)
+	if application == nil {
+		return
+	}
+	list, err := d.root.Application().Records(r.Context(), application, optionalQueryParameter(r, "pattern"))
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	out := make([]Record, len(list))
+	for idx := range list {
+		out[idx], err = recordFromResolver(r.Context(), d.root, list[idx], encoding)
+		if err != nil {
+			writeRPCError(w, err)
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
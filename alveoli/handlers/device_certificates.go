@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/certificates"
+	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+)
+
+// registerDeviceCertificates installs the device certificate handlers. The certificate and the revocation list of
+// account authorities are public, so brokers can fetch them without credentials.
+func registerDeviceCertificates(router *httprouter.Router, authProvider auth.Provider, root generated.ResolverRoot, authority *certificates.Authority) {
+	deviceCertificateHandler := &deviceCertificates{root: root, authority: authority}
+	router.Handler(http.MethodGet, "/device-certificates", authenticated(authProvider, deviceCertificateHandler.List))
+	router.Handler(http.MethodPost, "/device-certificates", authenticated(authProvider, deviceCertificateHandler.Issue))
+	router.Handler(http.MethodPost, "/device-certificates/:serialNumber/revoke", authenticated(authProvider, deviceCertificateHandler.Revoke))
+	router.GET("/device-authorities/:accountId/certificate", deviceCertificateHandler.AuthorityCertificate)
+	router.GET("/device-authorities/:accountId/crl", deviceCertificateHandler.CRL)
+}
+
+type deviceCertificates struct {
+	root      generated.ResolverRoot
+	authority *certificates.Authority
+}
+
+// IssueDeviceCertificateInput is the body expected when issuing a device certificate. A private key is generated
+// when CSR is empty.
+type IssueDeviceCertificateInput struct {
+	ApplicationProfileID string `json:"applicationProfileId"`
+	CSR                  string `json:"csr"`
+}
+
+func (d *deviceCertificates) List(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var applicationProfileID *string
+	if id := r.URL.Query().Get("applicationProfileId"); id != "" {
+		applicationProfileID = &id
+	}
+	list, err := d.root.Query().DeviceCertificates(r.Context(), applicationProfileID)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, list)
+}
+
+func (d *deviceCertificates) Issue(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	input := IssueDeviceCertificateInput{}
+	if !decodeBody(w, r, &input) {
+		return
+	}
+	if input.ApplicationProfileID == "" {
+		writeError(w, http.StatusBadRequest, "applicationProfileId is required")
+		return
+	}
+	var csr *string
+	if input.CSR != "" {
+		csr = &input.CSR
+	}
+	issued, err := d.root.Mutation().IssueDeviceCertificate(r.Context(), input.ApplicationProfileID, csr)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	out := IssuedDeviceCertificate{
+		DeviceCertificate:    *issued.DeviceCertificate,
+		CertificateAuthority: issued.CertificateAuthority,
+	}
+	if issued.PrivateKey != nil {
+		out.PrivateKey = *issued.PrivateKey
+	}
+	writeJSON(w, http.StatusCreated, out)
+}
+
+func (d *deviceCertificates) Revoke(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	out, err := d.root.Mutation().RevokeDeviceCertificate(r.Context(), ps.ByName("serialNumber"))
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (d *deviceCertificates) AuthorityCertificate(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	out, err := d.authority.Certificate(r.Context(), ps.ByName("accountId"))
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-pem-file")
+	w.Write([]byte(out))
+}
+
+func (d *deviceCertificates) CRL(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	out, err := d.authority.CRL(r.Context(), ps.ByName("accountId"))
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pkix-crl")
+	w.Write(out)
+}
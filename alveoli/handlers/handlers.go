@@ -1,12 +1,148 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
 	"github.com/julienschmidt/httprouter"
 	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/certificates"
+	"github.com/vx-labs/alveoli/alveoli/configuration"
+	"github.com/vx-labs/alveoli/alveoli/decoding"
+	"github.com/vx-labs/alveoli/alveoli/deletion"
+	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/graph/resolvers"
+	"github.com/vx-labs/alveoli/alveoli/provisioning"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	topicfilters "github.com/vx-labs/alveoli/alveoli/topics"
+	"github.com/vx-labs/alveoli/alveoli/usage"
+	nest "github.com/vx-labs/nest/nest/api"
 	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Register install resource handlers on the provided router
-func Register(router *httprouter.Router, authProvider auth.Provider, vespiaryClient vespiary.VespiaryClient) {
+func Register(router *httprouter.Router, authProvider auth.Provider, vespiaryClient vespiary.VespiaryClient, nestClient nest.MessagesClient, root generated.ResolverRoot, authority *certificates.Authority) {
 	registerAccounts(router, vespiaryClient, authProvider)
+	registerApplications(router, authProvider, root)
+	registerApplicationProfiles(router, authProvider, root)
+	registerRecordStreams(router, authProvider, root, nestClient)
+	registerRecordExports(router, authProvider, root, nestClient)
+	registerTakeout(router, authProvider, root, nestClient)
+	registerTopics(router, authProvider, root)
+	registerSessions(router, authProvider, root)
+	registerDeviceCertificates(router, authProvider, root, authority)
+	registerOpenAPI(router)
+}
+
+// authenticated wraps the given handler so that it only runs for users owning an account.
+func authenticated(authProvider auth.Provider, f func(w http.ResponseWriter, r *http.Request, ps httprouter.Params)) http.Handler {
+	return auth.Handler(authProvider, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth.RequireAccountCreated(f)(w, r, httprouter.ParamsFromContext(r.Context()))
+	}))
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	fmt.Fprintf(w, `{"status_code": %d, "message": %q}`, statusCode, message)
+}
+
+// writeRPCError maps an error returned by a resolver to an HTTP error response. Errors caused by the request are
+// reported with a 4xx status code, and only failures of the backend services become 502 responses.
+func writeRPCError(w http.ResponseWriter, err error) {
+	var inUseError *resolvers.ApplicationInUseError
+	var inputError *resolvers.InputError
+	var topicError *topicfilters.Error
+	var tenancyError *tenancy.Error
+	var certificateError *certificates.Error
+	var provisioningError *provisioning.Error
+	var configurationError *configuration.Error
+	var decodingError *decoding.Error
+	switch {
+	case errors.Is(err, usage.ErrQuotaExceeded):
+		writeError(w, http.StatusForbidden, err.Error())
+	case errors.As(err, &inUseError), errors.Is(err, deletion.ErrInProgress):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.As(err, &inputError), errors.As(err, &topicError), errors.As(err, &tenancyError),
+		errors.As(err, &certificateError), errors.As(err, &provisioningError), errors.As(err, &configurationError),
+		errors.As(err, &decodingError),
+		errors.Is(err, deletion.ErrInvalidConfirmation), errors.Is(err, deletion.ErrNotRequested):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, tenancy.ErrForeignTenant):
+		// Resources of other accounts are reported as missing, like the ones vespiary does not find.
+		writeError(w, http.StatusNotFound, "resource not found")
+	case errors.Is(err, certificates.ErrNotFound), errors.Is(err, certificates.ErrNoAuthority):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, certificates.ErrDisabled):
+		writeError(w, http.StatusNotImplemented, err.Error())
+	default:
+		switch status.Code(err) {
+		case codes.NotFound:
+			writeError(w, http.StatusNotFound, "resource not found")
+		case codes.InvalidArgument:
+			writeError(w, http.StatusBadRequest, status.Convert(err).Message())
+		case codes.AlreadyExists:
+			writeError(w, http.StatusConflict, "resource already exists")
+		case codes.PermissionDenied, codes.Unauthenticated:
+			writeError(w, http.StatusForbidden, "permission denied")
+		default:
+			log.Print(err)
+			writeError(w, http.StatusBadGateway, "upstream service failed")
+		}
+	}
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return false
+	}
+	return true
+}
+
+func optionalQueryParameter(r *http.Request, name string) *string {
+	values, ok := r.URL.Query()[name]
+	if !ok || len(values) == 0 {
+		return nil
+	}
+	return &values[0]
+}
+
+// applicationPattern returns the nest pattern matching the user provided pattern inside an application.
+func applicationPattern(accountID, applicationID string, userPattern *string) ([]byte, error) {
+	pattern := "#"
+	if userPattern != nil {
+		err := topicfilters.ValidateFilter(*userPattern)
+		if err != nil {
+			return nil, err
+		}
+		pattern = *userPattern
+	}
+	return tenancy.TopicPattern(accountID, applicationID, pattern), nil
+}
+
+// payloadEncodingParameter parses the optional "encoding" query parameter.
+func payloadEncodingParameter(r *http.Request) (*model.PayloadEncoding, error) {
+	value := optionalQueryParameter(r, "encoding")
+	if value == nil {
+		return nil, nil
+	}
+	encoding := model.PayloadEncoding(*value)
+	if !encoding.IsValid() {
+		return nil, fmt.Errorf("invalid encoding %q: supported values are UTF8, BASE64 and HEX", *value)
+	}
+	return &encoding, nil
 }
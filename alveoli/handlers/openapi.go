@@ -0,0 +1,468 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func registerOpenAPI(router *httprouter.Router) {
+	router.GET("/openapi.json", func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(openAPIDocument))
+	})
+}
+
+// openAPIDocument describes the REST API exposed by this package.
+// Keep it in sync with the routes installed by Register.
+const openAPIDocument = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "alveoli",
+    "description": "REST API mirroring the alveoli GraphQL schema.",
+    "version": "1.0.0"
+  },
+  "security": [{"bearerAuth": []}],
+  "paths": {
+    "/account/info": {
+      "get": {
+        "summary": "Get the caller's account informations",
+        "responses": {
+          "200": {"description": "Account informations", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/AccountInformations"}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      }
+    },
+    "/account/": {
+      "post": {
+        "summary": "Create an account for the caller",
+        "responses": {
+          "200": {"description": "Created account", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/AccountInformations"}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"},
+          "409": {"$ref": "#/components/responses/Conflict"}
+        }
+      }
+    },
+    "/account/export": {
+      "get": {
+        "summary": "Export the caller's account data",
+        "description": "Streams a zip archive holding account.json, applications.json, application-profiles.json and topics.json, and the records of each application as NDJSON in records/<application id>.ndjson. Profile passwords are not exported.",
+        "responses": {
+          "200": {"description": "Account archive", "content": {"application/zip": {"schema": {"type": "string", "format": "binary"}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      }
+    },
+    "/applications": {
+      "get": {
+        "summary": "List applications",
+        "responses": {
+          "200": {"description": "Applications", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Application"}}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      },
+      "post": {
+        "summary": "Create an application",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/CreateApplicationInput"}}}},
+        "responses": {
+          "201": {"description": "Created application", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Application"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "401": {"$ref": "#/components/responses/Unauthorized"},
+          "403": {"$ref": "#/components/responses/QuotaExceeded"}
+        }
+      }
+    },
+    "/applications/{id}": {
+      "parameters": [{"$ref": "#/components/parameters/ID"}],
+      "get": {
+        "summary": "Get an application",
+        "responses": {
+          "200": {"description": "Application", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Application"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      },
+      "delete": {
+        "summary": "Delete an application",
+        "description": "Deletes the application with its profiles, retained messages and configuration. Applications with connected sessions are never deleted, and applications with profiles or stored records are only deleted when force is set. Stored records are kept by the storage backend but are no longer listed.",
+        "parameters": [
+          {"name": "force", "in": "query", "required": false, "schema": {"type": "boolean"}}
+        ],
+        "responses": {
+          "204": {"description": "Application deleted"},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "404": {"$ref": "#/components/responses/NotFound"},
+          "409": {"description": "Application still has dependent resources", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}}
+        }
+      }
+    },
+    "/applications/{id}/profiles": {
+      "parameters": [{"$ref": "#/components/parameters/ID"}],
+      "get": {
+        "summary": "List the profiles of an application",
+        "responses": {
+          "200": {"description": "Application profiles", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/ApplicationProfile"}}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/applications/{id}/topics": {
+      "parameters": [{"$ref": "#/components/parameters/ID"}, {"$ref": "#/components/parameters/Pattern"}],
+      "get": {
+        "summary": "List the topics of an application",
+        "responses": {
+          "200": {"description": "Topics", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Topic"}}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/applications/{id}/topics/{name}": {
+      "parameters": [
+        {"$ref": "#/components/parameters/ID"},
+        {"name": "name", "in": "path", "required": true, "description": "Topic name, may contain slashes.", "schema": {"type": "string"}}
+      ],
+      "get": {
+        "summary": "Get a topic",
+        "responses": {
+          "200": {"description": "Topic", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Topic"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/applications/{id}/records": {
+      "parameters": [{"$ref": "#/components/parameters/ID"}, {"$ref": "#/components/parameters/Pattern"}, {"$ref": "#/components/parameters/Encoding"}],
+      "get": {
+        "summary": "List the records of an application",
+        "responses": {
+          "200": {"description": "Records", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Record"}}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/applications/{id}/records/stream": {
+      "parameters": [
+        {"$ref": "#/components/parameters/ID"},
+        {"$ref": "#/components/parameters/Pattern"},
+        {"$ref": "#/components/parameters/Encoding"},
+        {"name": "Last-Event-ID", "in": "header", "required": false, "description": "Resume the stream after the record having this event ID.", "schema": {"type": "string"}}
+      ],
+      "get": {
+        "summary": "Stream the records of an application as Server-Sent Events",
+        "description": "Each record is sent as a \"record\" event. Records are sent in the order nest stored them, which may differ from their timestamp order. Event IDs are \"<timestamp>.<count>\": the timestamp in nanoseconds the stream was opened from, and the number of records sent since. Resuming replays the records from this timestamp and skips the records already sent, so reconnecting to a long-lived stream takes longer as the stream grows. Clients must send the Authorization header: EventSource clients need an implementation able to set request headers.",
+        "responses": {
+          "200": {"description": "Record stream", "content": {"text/event-stream": {"schema": {"type": "string"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/applications/{id}/records/export": {
+      "parameters": [
+        {"$ref": "#/components/parameters/ID"},
+        {"$ref": "#/components/parameters/Pattern"},
+        {"$ref": "#/components/parameters/Encoding"},
+        {"name": "format", "in": "query", "required": false, "description": "Export format, defaults to ndjson.", "schema": {"type": "string", "enum": ["csv", "ndjson", "parquet"]}},
+        {"name": "from", "in": "query", "required": false, "description": "Export records sent after this date, defaults to 15 days ago. Records no longer covered by the application retention policies are never exported.", "schema": {"type": "string", "format": "date-time"}},
+        {"name": "to", "in": "query", "required": false, "description": "Export records sent before this date, defaults to now.", "schema": {"type": "string", "format": "date-time"}}
+      ],
+      "get": {
+        "summary": "Export the records of an application",
+        "description": "Records are exported with their topic, sender, timestamp and payload. Text formats also include the payload encoding, while parquet stores raw payloads.",
+        "responses": {
+          "200": {
+            "description": "Exported records",
+            "content": {
+              "text/csv": {"schema": {"type": "string"}},
+              "application/x-ndjson": {"schema": {"type": "string"}},
+              "application/vnd.apache.parquet": {"schema": {"type": "string", "format": "binary"}}
+            }
+          },
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/application-profiles": {
+      "get": {
+        "summary": "List application profiles",
+        "responses": {
+          "200": {"description": "Application profiles", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/ApplicationProfile"}}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      },
+      "post": {
+        "summary": "Create an application profile",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/CreateApplicationProfileInput"}}}},
+        "responses": {
+          "201": {"description": "Created application profile, with the generated password when none was provided", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/CreatedApplicationProfile"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "401": {"$ref": "#/components/responses/Unauthorized"},
+          "403": {"$ref": "#/components/responses/QuotaExceeded"}
+        }
+      }
+    },
+    "/application-profiles/{id}": {
+      "parameters": [{"$ref": "#/components/parameters/ID"}],
+      "get": {
+        "summary": "Get an application profile",
+        "responses": {
+          "200": {"description": "Application profile", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ApplicationProfile"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      },
+      "delete": {
+        "summary": "Delete an application profile",
+        "responses": {
+          "204": {"description": "Application profile deleted"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/topics": {
+      "parameters": [{"$ref": "#/components/parameters/Pattern"}],
+      "get": {
+        "summary": "List topics across all applications",
+        "responses": {
+          "200": {"description": "Topics", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Topic"}}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      }
+    },
+    "/sessions": {
+      "get": {
+        "summary": "List connected sessions",
+        "responses": {
+          "200": {"description": "Sessions", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Session"}}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      }
+    },
+    "/sessions/{id}": {
+      "parameters": [{"$ref": "#/components/parameters/ID"}],
+      "get": {
+        "summary": "Get a connected session",
+        "responses": {
+          "200": {"description": "Session", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Session"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/device-certificates": {
+      "get": {
+        "summary": "List issued device certificates",
+        "parameters": [{"name": "applicationProfileId", "in": "query", "required": false, "schema": {"type": "string"}}],
+        "responses": {
+          "200": {"description": "Device certificates", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/DeviceCertificate"}}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      },
+      "post": {
+        "summary": "Issue a device certificate (issuance-only)",
+        "description": "Signs the CSR, or generates a key pair when it is omitted, with the account device certificate authority. The certificate subject identifies the application profile, whatever the CSR subject. The wasp and vespiary brokers do not accept client certificates yet: devices connecting to them must keep using their profile password, and certificates are only useful with brokers configured to trust the account authority.",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/IssueDeviceCertificateInput"}}}},
+        "responses": {
+          "201": {"description": "Issued device certificate, with the generated private key when no CSR was provided", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/IssuedDeviceCertificate"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "401": {"$ref": "#/components/responses/Unauthorized"},
+          "404": {"$ref": "#/components/responses/NotFound"},
+          "501": {"$ref": "#/components/responses/DeviceCertificatesDisabled"}
+        }
+      }
+    },
+    "/device-certificates/{serialNumber}/revoke": {
+      "parameters": [{"name": "serialNumber", "in": "path", "required": true, "schema": {"type": "string"}}],
+      "post": {
+        "summary": "Revoke a device certificate",
+        "responses": {
+          "200": {"description": "Revoked device certificate", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/DeviceCertificate"}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/device-authorities/{accountId}/certificate": {
+      "parameters": [{"$ref": "#/components/parameters/AccountID"}],
+      "get": {
+        "summary": "Get the device certificate authority of an account",
+        "security": [],
+        "responses": {
+          "200": {"description": "PEM encoded certificate", "content": {"application/x-pem-file": {"schema": {"type": "string"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/device-authorities/{accountId}/crl": {
+      "parameters": [{"$ref": "#/components/parameters/AccountID"}],
+      "get": {
+        "summary": "Get the device certificate revocation list of an account",
+        "description": "The list is signed by the account device certificate authority, and must be fetched again before its next update, one day after it was generated. The same signed list is served until a certificate is revoked or half of its validity has elapsed.",
+        "security": [],
+        "responses": {
+          "200": {"description": "DER encoded certificate revocation list", "content": {"application/pkix-crl": {"schema": {"type": "string", "format": "binary"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"},
+          "501": {"$ref": "#/components/responses/DeviceCertificatesDisabled"}
+        }
+      }
+    }
+  },
+  "components": {
+    "securitySchemes": {
+      "bearerAuth": {"type": "http", "scheme": "bearer"}
+    },
+    "parameters": {
+      "ID": {"name": "id", "in": "path", "required": true, "schema": {"type": "string"}},
+      "AccountID": {"name": "accountId", "in": "path", "required": true, "schema": {"type": "string"}},
+      "Encoding": {"name": "encoding", "in": "query", "required": false, "description": "Payload encoding. Defaults to UTF8 for valid UTF-8 payloads and BASE64 otherwise.", "schema": {"$ref": "#/components/schemas/PayloadEncoding"}},
+      "Pattern": {"name": "pattern", "in": "query", "required": false, "description": "MQTT topic filter, defaults to #.", "schema": {"type": "string"}}
+    },
+    "responses": {
+      "BadRequest": {"description": "Invalid request", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}},
+      "Unauthorized": {"description": "Missing or invalid credentials", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}},
+      "NotFound": {"description": "Resource not found", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}},
+      "Conflict": {"description": "Resource already exists", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}},
+      "QuotaExceeded": {"description": "Account quota exceeded", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}},
+      "DeviceCertificatesDisabled": {"description": "Device certificates are not enabled on this server", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}}
+    },
+    "schemas": {
+      "Error": {
+        "type": "object",
+        "properties": {
+          "status_code": {"type": "integer"},
+          "message": {"type": "string"},
+          "reason": {"type": "string"}
+        }
+      },
+      "AccountInformations": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "string"},
+          "usernames": {"type": "array", "items": {"type": "string"}}
+        }
+      },
+      "Application": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "string"},
+          "name": {"type": "string"}
+        }
+      },
+      "CreateApplicationInput": {
+        "type": "object",
+        "required": ["name"],
+        "properties": {
+          "name": {"type": "string"}
+        }
+      },
+      "ApplicationProfile": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "string"},
+          "name": {"type": "string"},
+          "applicationId": {"type": "string"},
+          "enabled": {"type": "boolean"}
+        }
+      },
+      "CreateApplicationProfileInput": {
+        "type": "object",
+        "required": ["name", "applicationId"],
+        "properties": {
+          "name": {"type": "string"},
+          "applicationId": {"type": "string"},
+          "password": {"type": "string", "format": "password", "description": "Generated when omitted"}
+        }
+      },
+      "DeviceConnection": {
+        "type": "object",
+        "properties": {
+          "host": {"type": "string"},
+          "port": {"type": "integer"},
+          "tls": {"type": "boolean"},
+          "serverName": {"type": "string"},
+          "username": {"type": "string"},
+          "clientIdHint": {"type": "string"},
+          "mountPoint": {"type": "string"}
+        }
+      },
+      "CreatedApplicationProfile": {
+        "allOf": [
+          {"$ref": "#/components/schemas/ApplicationProfile"},
+          {
+            "type": "object",
+            "properties": {
+              "generatedPassword": {"type": "string", "format": "password"},
+              "connection": {"$ref": "#/components/schemas/DeviceConnection"}
+            }
+          }
+        ]
+      },
+      "DeviceCertificate": {
+        "type": "object",
+        "description": "X.509 client certificate issued for an application profile. Device certificates are issuance-only: the wasp and vespiary brokers do not accept them, and devices must keep using their profile password to connect to them.",
+        "properties": {
+          "serialNumber": {"type": "string"},
+          "applicationId": {"type": "string"},
+          "applicationProfileId": {"type": "string"},
+          "subject": {"type": "string"},
+          "notBefore": {"type": "string", "format": "date-time"},
+          "notAfter": {"type": "string", "format": "date-time"},
+          "revokedAt": {"type": "string", "format": "date-time"},
+          "certificate": {"type": "string"}
+        }
+      },
+      "IssueDeviceCertificateInput": {
+        "type": "object",
+        "required": ["applicationProfileId"],
+        "properties": {
+          "applicationProfileId": {"type": "string"},
+          "csr": {"type": "string", "description": "PEM encoded certificate signing request"}
+        }
+      },
+      "IssuedDeviceCertificate": {
+        "type": "object",
+        "properties": {
+          "deviceCertificate": {"$ref": "#/components/schemas/DeviceCertificate"},
+          "privateKey": {"type": "string", "format": "password"},
+          "certificateAuthority": {"type": "string"}
+        }
+      },
+      "Topic": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "applicationId": {"type": "string"},
+          "guessedContentType": {"type": "string"},
+          "messageCount": {"type": "integer"},
+          "sizeInBytes": {"type": "integer"},
+          "lastRecord": {"$ref": "#/components/schemas/Record"}
+        }
+      },
+      "Record": {
+        "type": "object",
+        "properties": {
+          "topicName": {"type": "string"},
+          "applicationId": {"type": "string"},
+          "payload": {"type": "string"},
+          "payloadEncoding": {"allOf": [{"$ref": "#/components/schemas/PayloadEncoding"}], "description": "Encoding used for payload: the requested encoding, or the detected one."},
+          "payloadSize": {"type": "integer"},
+          "sentBy": {"type": "string"},
+          "sentAt": {"type": "string", "format": "date-time"}
+        }
+      },
+      "PayloadEncoding": {"type": "string", "enum": ["UTF8", "BASE64", "HEX"]},
+      "Session": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "string"},
+          "clientId": {"type": "string"},
+          "applicationId": {"type": "string"},
+          "applicationProfileId": {"type": "string"},
+          "connectedAt": {"type": "string", "format": "date-time"}
+        }
+      }
+    }
+  }
+}
+`
@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	"github.com/vx-labs/alveoli/alveoli/retention"
+	"github.com/vx-labs/alveoli/alveoli/store"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	nest "github.com/vx-labs/nest/nest/api"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+const (
+	defaultExportWindow = 15 * 24 * time.Hour
+	// parquetRowGroupSize bounds the amount of records buffered in memory by the parquet encoder.
+	parquetRowGroupSize = 8 * 1024 * 1024
+)
+
+func registerRecordExports(router *httprouter.Router, authProvider auth.Provider, root generated.ResolverRoot, nestClient nest.MessagesClient) {
+	exportHandler := &recordExports{
+		applications: &applications{root: root},
+		root:         root,
+		nest:         nestClient,
+		store:        store.New(nestClient),
+	}
+	router.Handler(http.MethodGet, "/applications/:id/records/export", authenticated(authProvider, exportHandler.Export))
+}
+
+type recordExports struct {
+	applications *applications
+	root         generated.ResolverRoot
+	nest         nest.MessagesClient
+	store        *store.Store
+}
+
+type exportedRecord struct {
+	Topic     string
+	Sender    string
+	Timestamp int64
+	// Payload holds the raw record payload, used by binary formats.
+	Payload []byte
+	// EncodedPayload holds the payload encoded using PayloadEncoding, used by text formats.
+	EncodedPayload  string
+	PayloadEncoding string
+}
+
+type parquetRecord struct {
+	Topic     string `parquet:"name=topic, type=UTF8"`
+	Sender    string `parquet:"name=sender, type=UTF8"`
+	Timestamp int64  `parquet:"name=timestamp, type=TIMESTAMP_MICROS"`
+	Payload   string `parquet:"name=payload, type=BYTE_ARRAY"`
+}
+
+type recordEncoder interface {
+	Encode(record exportedRecord) error
+	Close() error
+}
+
+type csvRecordEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvRecordEncoder) Encode(record exportedRecord) error {
+	return e.w.Write([]string{
+		record.Topic,
+		record.Sender,
+		time.Unix(0, record.Timestamp).UTC().Format(time.RFC3339Nano),
+		record.EncodedPayload,
+		record.PayloadEncoding,
+	})
+}
+func (e *csvRecordEncoder) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type ndjsonRecordEncoder struct {
+	w *json.Encoder
+}
+
+func (e *ndjsonRecordEncoder) Encode(record exportedRecord) error {
+	return e.w.Encode(struct {
+		Topic           string    `json:"topic"`
+		Sender          string    `json:"sender"`
+		Timestamp       time.Time `json:"timestamp"`
+		Payload         string    `json:"payload"`
+		PayloadEncoding string    `json:"payloadEncoding"`
+	}{
+		Topic:           record.Topic,
+		Sender:          record.Sender,
+		Timestamp:       time.Unix(0, record.Timestamp).UTC(),
+		Payload:         record.EncodedPayload,
+		PayloadEncoding: record.PayloadEncoding,
+	})
+}
+func (e *ndjsonRecordEncoder) Close() error { return nil }
+
+type parquetRecordEncoder struct {
+	w *writer.ParquetWriter
+}
+
+func (e *parquetRecordEncoder) Encode(record exportedRecord) error {
+	return e.w.Write(parquetRecord{
+		Topic:     record.Topic,
+		Sender:    record.Sender,
+		Timestamp: record.Timestamp / int64(time.Microsecond),
+		Payload:   string(record.Payload),
+	})
+}
+func (e *parquetRecordEncoder) Close() error {
+	return e.w.WriteStop()
+}
+
+var exportContentTypes = map[string]string{
+	"csv":     "text/csv",
+	"ndjson":  "application/x-ndjson",
+	"parquet": "application/vnd.apache.parquet",
+}
+
+func newRecordEncoder(format string, w io.Writer) (recordEncoder, error) {
+	switch format {
+	case "csv":
+		encoder := csv.NewWriter(w)
+		err := encoder.Write([]string{"topic", "sender", "timestamp", "payload", "payload_encoding"})
+		if err != nil {
+			return nil, err
+		}
+		return &csvRecordEncoder{w: encoder}, nil
+	case "ndjson":
+		return &ndjsonRecordEncoder{w: json.NewEncoder(w)}, nil
+	case "parquet":
+		encoder, err := writer.NewParquetWriterFromWriter(w, new(parquetRecord), 1)
+		if err != nil {
+			return nil, err
+		}
+		encoder.RowGroupSize = parquetRowGroupSize
+		return &parquetRecordEncoder{w: encoder}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func parseTimeParameter(r *http.Request, name string, defaultValue time.Time) (time.Time, error) {
+	value := optionalQueryParameter(r, name)
+	if value == nil {
+		return defaultValue, nil
+	}
+	return time.Parse(time.RFC3339, *value)
+}
+
+// Export streams the records of an application matching the provided pattern and time range.
+// Records are written as they are received from nest, so the result set is never fully buffered.
+// Records no longer covered by the application retention policies are not exported.
+func (d *recordExports) Export(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	now := time.Now()
+	from, err := parseTimeParameter(r, "from", now.Add(-defaultExportWindow))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid from parameter: expected a RFC3339 timestamp")
+		return
+	}
+	to, err := parseTimeParameter(r, "to", now)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid to parameter: expected a RFC3339 timestamp")
+		return
+	}
+	if to.Before(from) {
+		writeError(w, http.StatusBadRequest, "to must not be before from")
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "ndjson"
+	}
+	contentType, ok := exportContentTypes[format]
+	if !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported format %q", format))
+		return
+	}
+	encoding, err := payloadEncodingParameter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	application := d.applications.application(w, r, ps)
+	if application == nil {
+		return
+	}
+	authContext := auth.Informations(r.Context())
+	ctx := r.Context()
+	pattern, err := applicationPattern(authContext.AccountID, application.ID, optionalQueryParameter(r, "pattern"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	policies, err := retention.LoadEffective(ctx, d.store, d.nest, authContext.AccountID, application.ID, now)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	if window := retention.Window(policies, now); window.After(from) {
+		from = window
+	}
+	prefix := tenancy.TopicPrefix(authContext.AccountID, application.ID)
+	// Nest sends the records stored after the first record not older than from, whatever their timestamp.
+	retained := func(record *nest.Record) bool {
+		return record.Timestamp >= from.UnixNano() && retention.Retained(policies, strings.TrimPrefix(string(record.Topic), prefix), record.Timestamp, now)
+	}
+	stream, err := d.nest.GetTopics(ctx, &nest.GetTopicsRequest{
+		Pattern:       pattern,
+		Watch:         false,
+		FromTimestamp: from.UnixNano(),
+	})
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-records.%s"`, application.ID, format))
+	w.WriteHeader(http.StatusOK)
+	encoder, err := newRecordEncoder(format, w)
+	if err != nil {
+		log.Printf("record export failed to start: %v", err)
+		return
+	}
+	flush := func() {}
+	if flusher, ok := w.(http.Flusher); ok && format != "parquet" {
+		flush = flusher.Flush
+	}
+	count, err := streamRecords(ctx, d.root, stream, encoder, to, retained, encoding, flush)
+	if err != nil {
+		// Headers are already sent: the truncated body is the only way to signal the failure.
+		log.Printf("record export failed after %d records: %v", count, err)
+		return
+	}
+	if err := encoder.Close(); err != nil {
+		log.Printf("record export failed to finalize: %v", err)
+	}
+}
+
+// streamRecords encodes the records received from stream and sent before to, calling flush after each batch.
+// When retained is not nil, records it rejects are skipped.
+// It returns the number of encoded records.
+func streamRecords(ctx context.Context, root generated.ResolverRoot, stream nest.Messages_GetTopicsClient, encoder recordEncoder, to time.Time, retained func(*nest.Record) bool, encoding *model.PayloadEncoding, flush func()) (int, error) {
+	count := 0
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		for _, record := range msg.Records {
+			if record.Timestamp > to.UnixNano() || (retained != nil && !retained(record)) {
+				continue
+			}
+			out, err := recordFromResolver(ctx, root, record, encoding)
+			if err != nil {
+				log.Printf("record export: %v", err)
+				continue
+			}
+			err = encoder.Encode(exportedRecord{
+				Topic:           out.TopicName,
+				Sender:          out.SentBy,
+				Timestamp:       record.Timestamp,
+				Payload:         record.Payload,
+				EncodedPayload:  out.Payload,
+				PayloadEncoding: out.PayloadEncoding,
+			})
+			if err != nil {
+				return count, err
+			}
+			count++
+		}
+		flush()
+	}
+}
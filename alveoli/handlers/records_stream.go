@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+	nest "github.com/vx-labs/nest/nest/api"
+)
+
+const recordStreamKeepaliveInterval = 15 * time.Second
+
+func registerRecordStreams(router *httprouter.Router, authProvider auth.Provider, root generated.ResolverRoot, nestClient nest.MessagesClient) {
+	streamHandler := &recordStreams{
+		applications: &applications{root: root},
+		root:         root,
+		nest:         nestClient,
+	}
+	router.Handler(http.MethodGet, "/applications/:id/records/stream", authenticated(authProvider, streamHandler.Stream))
+}
+
+// streamCursor identifies the last record sent on a stream: the timestamp the stream was opened from, and the number
+// of records delivered since. Nest exposes no record offsets, and delivers records in the order they were appended to
+// its log, which is not the timestamp order when producers clocks drift: the cursor therefore counts records from a
+// fixed starting point instead of comparing timestamps. It is encoded as "<timestamp>.<count>" in event IDs.
+type streamCursor struct {
+	timestamp int64
+	count     int
+}
+
+func (c streamCursor) String() string {
+	return fmt.Sprintf("%d.%d", c.timestamp, c.count)
+}
+
+// parseStreamCursor decodes a Last-Event-ID header. A bare timestamp resumes with the records appended after the
+// first record having a later timestamp.
+func parseStreamCursor(value string) (streamCursor, error) {
+	tokens := strings.SplitN(value, ".", 2)
+	timestamp, err := strconv.ParseInt(tokens[0], 10, 64)
+	if err != nil {
+		return streamCursor{}, err
+	}
+	if len(tokens) == 1 {
+		if timestamp == math.MaxInt64 {
+			return streamCursor{}, errors.New("invalid timestamp")
+		}
+		return streamCursor{timestamp: timestamp + 1}, nil
+	}
+	count, err := strconv.Atoi(tokens[1])
+	if err != nil || count < 0 {
+		return streamCursor{}, errors.New("invalid count")
+	}
+	return streamCursor{timestamp: timestamp, count: count}, nil
+}
+
+type recordStreams struct {
+	applications *applications
+	root         generated.ResolverRoot
+	nest         nest.MessagesClient
+}
+
+// Stream sends records published on the application topics as Server-Sent Events.
+// Event IDs are stream cursors, allowing clients to resume using the Last-Event-ID header without missing records.
+// Resuming replays the application records from the timestamp the first stream was opened from, and skips the
+// records already delivered: reconnecting to a long-lived stream reads all the records it already sent.
+func (d *recordStreams) Stream(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming is not supported")
+		return
+	}
+	from := streamCursor{timestamp: time.Now().UnixNano()}
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		cursor, err := parseStreamCursor(lastEventID)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid Last-Event-ID header")
+			return
+		}
+		from = cursor
+	}
+	encoding, err := payloadEncodingParameter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	application := d.applications.application(w, r, ps)
+	if application == nil {
+		return
+	}
+	authContext := auth.Informations(r.Context())
+	ctx := r.Context()
+	pattern, err := applicationPattern(authContext.AccountID, application.ID, optionalQueryParameter(r, "pattern"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	stream, err := d.nest.GetTopics(ctx, &nest.GetTopicsRequest{
+		Pattern:       pattern,
+		Watch:         true,
+		FromTimestamp: from.timestamp,
+	})
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	records := make(chan []*nest.Record)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(records)
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case records <- msg.Records:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(recordStreamKeepaliveInterval)
+	defer ticker.Stop()
+	// Records are delivered in log order from the cursor timestamp, so the same records are delivered in the same
+	// order every time the stream is opened from this timestamp.
+	cursor := streamCursor{timestamp: from.timestamp}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-errs:
+			if ctx.Err() == nil {
+				log.Printf("record stream failed: %v", err)
+				fmt.Fprintf(w, "event: error\ndata: %q\n\n", "record stream interrupted")
+				flusher.Flush()
+			}
+			return
+		case <-ticker.C:
+			fmt.Fprint(w, ": keepalive\n\n")
+			flusher.Flush()
+		case batch, ok := <-records:
+			if !ok {
+				return
+			}
+			for _, record := range batch {
+				cursor.count++
+				// Resuming from a Last-Event-ID must not send the same record twice.
+				if cursor.count <= from.count {
+					continue
+				}
+				out, err := recordFromResolver(ctx, d.root, record, encoding)
+				if err != nil {
+					log.Printf("failed to convert record: %v", err)
+					continue
+				}
+				data, err := json.Marshal(out)
+				if err != nil {
+					log.Printf("failed to encode record: %v", err)
+					continue
+				}
+				fmt.Fprintf(w, "id: %s\nevent: record\ndata: %s\n\n", cursor, data)
+			}
+			flusher.Flush()
+		}
+	}
+}
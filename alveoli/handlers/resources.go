@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/certificates"
+	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+	"github.com/vx-labs/alveoli/alveoli/graph/model"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+)
+
+// Application is the REST representation of a vespiary application.
+type Application struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ApplicationProfile is the REST representation of a vespiary application profile.
+type ApplicationProfile struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	ApplicationID string `json:"applicationId"`
+	Enabled       bool   `json:"enabled"`
+}
+
+// DeviceConnection is the REST representation of the details devices need to connect with an application profile.
+type DeviceConnection struct {
+	Host         string `json:"host"`
+	Port         int    `json:"port"`
+	TLS          bool   `json:"tls"`
+	ServerName   string `json:"serverName"`
+	Username     string `json:"username"`
+	ClientIDHint string `json:"clientIdHint"`
+	MountPoint   string `json:"mountPoint"`
+}
+
+// CreatedApplicationProfile is returned when creating an application profile. GeneratedPassword is only set when
+// alveoli generated the password, and cannot be retrieved later.
+type CreatedApplicationProfile struct {
+	ApplicationProfile
+	GeneratedPassword string           `json:"generatedPassword,omitempty"`
+	Connection        DeviceConnection `json:"connection"`
+}
+
+// DeviceCertificate is the REST representation of an issued device certificate.
+type DeviceCertificate = certificates.Certificate
+
+// IssuedDeviceCertificate is returned when issuing a device certificate. PrivateKey is only set when alveoli
+// generated the key pair, and cannot be retrieved later.
+type IssuedDeviceCertificate struct {
+	DeviceCertificate    DeviceCertificate `json:"deviceCertificate"`
+	PrivateKey           string            `json:"privateKey,omitempty"`
+	CertificateAuthority string            `json:"certificateAuthority"`
+}
+
+// Topic is the REST representation of a nest topic.
+type Topic struct {
+	Name               string  `json:"name"`
+	ApplicationID      string  `json:"applicationId"`
+	GuessedContentType string  `json:"guessedContentType"`
+	MessageCount       int     `json:"messageCount"`
+	SizeInBytes        int     `json:"sizeInBytes"`
+	LastRecord         *Record `json:"lastRecord,omitempty"`
+}
+
+// Record is the REST representation of a nest record.
+type Record struct {
+	TopicName       string    `json:"topicName"`
+	ApplicationID   string    `json:"applicationId"`
+	Payload         string    `json:"payload"`
+	PayloadEncoding string    `json:"payloadEncoding"`
+	PayloadSize     int       `json:"payloadSize"`
+	SentBy          string    `json:"sentBy"`
+	SentAt          time.Time `json:"sentAt"`
+}
+
+// Session is the REST representation of a wasp session.
+type Session struct {
+	ID                   string    `json:"id"`
+	ClientID             string    `json:"clientId"`
+	ApplicationID        string    `json:"applicationId"`
+	ApplicationProfileID string    `json:"applicationProfileId"`
+	ConnectedAt          time.Time `json:"connectedAt"`
+}
+
+// The following functions build REST representations using the GraphQL field resolvers,
+// so both APIs expose the same data.
+
+func applicationFromResolver(ctx context.Context, root generated.ResolverRoot, obj *vespiary.Application) (Application, error) {
+	id, err := root.Application().ID(ctx, obj)
+	if err != nil {
+		return Application{}, err
+	}
+	name, err := root.Application().Name(ctx, obj)
+	if err != nil {
+		return Application{}, err
+	}
+	return Application{ID: id, Name: name}, nil
+}
+
+func applicationProfileFromResolver(ctx context.Context, root generated.ResolverRoot, obj *vespiary.ApplicationProfile) (ApplicationProfile, error) {
+	resolver := root.ApplicationProfile()
+	id, err := resolver.ID(ctx, obj)
+	if err != nil {
+		return ApplicationProfile{}, err
+	}
+	name, err := resolver.Name(ctx, obj)
+	if err != nil {
+		return ApplicationProfile{}, err
+	}
+	applicationID, err := resolver.ApplicationID(ctx, obj)
+	if err != nil {
+		return ApplicationProfile{}, err
+	}
+	enabled, err := resolver.Enabled(ctx, obj)
+	if err != nil {
+		return ApplicationProfile{}, err
+	}
+	return ApplicationProfile{ID: id, Name: name, ApplicationID: applicationID, Enabled: enabled}, nil
+}
+
+func topicFromResolver(ctx context.Context, root generated.ResolverRoot, obj *nest.TopicMetadata) (Topic, error) {
+	resolver := root.Topic()
+	name, err := resolver.Name(ctx, obj)
+	if err != nil {
+		return Topic{}, err
+	}
+	applicationID, err := resolver.ApplicationID(ctx, obj)
+	if err != nil {
+		return Topic{}, err
+	}
+	messageCount, err := resolver.MessageCount(ctx, obj)
+	if err != nil {
+		return Topic{}, err
+	}
+	sizeInBytes, err := resolver.SizeInBytes(ctx, obj)
+	if err != nil {
+		return Topic{}, err
+	}
+	out := Topic{
+		Name:               name,
+		ApplicationID:      applicationID,
+		GuessedContentType: obj.GuessedContentType,
+		MessageCount:       messageCount,
+		SizeInBytes:        sizeInBytes,
+	}
+	lastRecord, err := resolver.LastRecord(ctx, obj)
+	if err != nil {
+		return Topic{}, err
+	}
+	if lastRecord != nil {
+		record, err := recordFromResolver(ctx, root, lastRecord, nil)
+		if err != nil {
+			return Topic{}, err
+		}
+		out.LastRecord = &record
+	}
+	return out, nil
+}
+
+func recordFromResolver(ctx context.Context, root generated.ResolverRoot, obj *nest.Record, encoding *model.PayloadEncoding) (Record, error) {
+	resolver := root.Record()
+	topicName, err := resolver.TopicName(ctx, obj)
+	if err != nil {
+		return Record{}, err
+	}
+	applicationID, err := resolver.ApplicationID(ctx, obj)
+	if err != nil {
+		return Record{}, err
+	}
+	if encoding == nil {
+		detected, err := resolver.PayloadEncoding(ctx, obj)
+		if err != nil {
+			return Record{}, err
+		}
+		encoding = &detected
+	}
+	payload, err := resolver.Payload(ctx, obj, encoding)
+	if err != nil {
+		return Record{}, err
+	}
+	payloadSize, err := resolver.PayloadSize(ctx, obj)
+	if err != nil {
+		return Record{}, err
+	}
+	sentBy, err := resolver.SentBy(ctx, obj)
+	if err != nil {
+		return Record{}, err
+	}
+	sentAt, err := resolver.SentAt(ctx, obj)
+	if err != nil {
+		return Record{}, err
+	}
+	return Record{
+		TopicName:       topicName,
+		ApplicationID:   applicationID,
+		Payload:         payload,
+		PayloadEncoding: encoding.String(),
+		PayloadSize:     payloadSize,
+		SentBy:          sentBy,
+		SentAt:          *sentAt,
+	}, nil
+}
+
+func sessionFromResolver(ctx context.Context, root generated.ResolverRoot, obj *wasp.SessionMetadatas) (Session, error) {
+	resolver := root.Session()
+	id, err := resolver.ID(ctx, obj)
+	if err != nil {
+		return Session{}, err
+	}
+	clientID, err := resolver.ClientID(ctx, obj)
+	if err != nil {
+		return Session{}, err
+	}
+	applicationID, err := resolver.ApplicationID(ctx, obj)
+	if err != nil {
+		return Session{}, err
+	}
+	applicationProfileID, err := resolver.ApplicationProfileID(ctx, obj)
+	if err != nil {
+		return Session{}, err
+	}
+	connectedAt, err := resolver.ConnectedAt(ctx, obj)
+	if err != nil {
+		return Session{}, err
+	}
+	return Session{
+		ID:                   id,
+		ClientID:             clientID,
+		ApplicationID:        applicationID,
+		ApplicationProfileID: applicationProfileID,
+		ConnectedAt:          *connectedAt,
+	}, nil
+}
+
+func deviceConnectionFromResolver(ctx context.Context, root generated.ResolverRoot, obj *vespiary.ApplicationProfile) (DeviceConnection, error) {
+	connection, err := root.ApplicationProfile().Connection(ctx, obj)
+	if err != nil {
+		return DeviceConnection{}, err
+	}
+	return DeviceConnection{
+		Host:         connection.Host,
+		Port:         connection.Port,
+		TLS:          connection.TLS,
+		ServerName:   connection.ServerName,
+		Username:     connection.Username,
+		ClientIDHint: connection.ClientIDHint,
+		MountPoint:   connection.MountPoint,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+)
+
+func registerSessions(router *httprouter.Router, authProvider auth.Provider, root generated.ResolverRoot) {
+	sessionHandler := &sessions{root: root}
+	router.Handler(http.MethodGet, "/sessions", authenticated(authProvider, sessionHandler.List))
+	router.Handler(http.MethodGet, "/sessions/:id", authenticated(authProvider, sessionHandler.Get))
+}
+
+type sessions struct {
+	root generated.ResolverRoot
+}
+
+func (d *sessions) list(r *http.Request) ([]Session, error) {
+	list, err := d.root.Query().Sessions(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Session, len(list))
+	for idx := range list {
+		out[idx], err = sessionFromResolver(r.Context(), d.root, list[idx])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (d *sessions) List(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	out, err := d.list(r)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (d *sessions) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	list, err := d.list(r)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	for _, session := range list {
+		if session.ID == ps.ByName("id") {
+			writeJSON(w, http.StatusOK, session)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "session not found")
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+func registerTakeout(router *httprouter.Router, authProvider auth.Provider, root generated.ResolverRoot, nestClient nest.MessagesClient) {
+	takeoutHandler := &takeout{root: root, nest: nestClient}
+	router.Handler(http.MethodGet, "/account/export", authenticated(authProvider, takeoutHandler.Export))
+}
+
+type takeout struct {
+	root generated.ResolverRoot
+	nest nest.MessagesClient
+}
+
+// Account is the REST representation of a vespiary account.
+type Account struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// takeoutArchive writes the files of an account export in a zip archive.
+// Zip entries do not need to be sized upfront, so files are streamed to the client as they are built.
+type takeoutArchive struct {
+	w        *zip.Writer
+	flusher  http.Flusher
+	modified time.Time
+}
+
+// flush sends the data written so far to the client.
+func (a *takeoutArchive) flush() {
+	if a.w.Flush() == nil && a.flusher != nil {
+		a.flusher.Flush()
+	}
+}
+
+func (a *takeoutArchive) create(name string) (io.Writer, error) {
+	a.flush()
+	return a.w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: a.modified})
+}
+
+func (a *takeoutArchive) writeJSON(name string, v interface{}) error {
+	w, err := a.create(name)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// Export streams a zip archive with the account, its applications, application profiles, topics and records.
+// Profile passwords are never exported.
+func (d *takeout) Export(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := r.Context()
+	authContext := auth.Informations(ctx)
+	account, err := d.root.Query().Account(ctx)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	applications, err := d.root.Query().Applications(ctx)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	now := time.Now()
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-export-%s.zip"`, authContext.AccountID, now.UTC().Format("20060102T150405Z")))
+	w.WriteHeader(http.StatusOK)
+	archive := &takeoutArchive{w: zip.NewWriter(w), modified: now}
+	if flusher, ok := w.(http.Flusher); ok {
+		archive.flusher = flusher
+	}
+	err = d.write(ctx, archive, Account{ID: account.ID, Name: account.Name}, applications, now)
+	if err != nil {
+		// Headers are already sent: the truncated archive is the only way to signal the failure.
+		log.Printf("account export failed: %v", err)
+		return
+	}
+	if err := archive.w.Close(); err != nil {
+		log.Printf("account export failed to finalize: %v", err)
+	}
+}
+
+func (d *takeout) write(ctx context.Context, archive *takeoutArchive, account Account, list []*vespiary.Application, now time.Time) error {
+	err := archive.writeJSON("account.json", account)
+	if err != nil {
+		return err
+	}
+	applications := make([]Application, len(list))
+	for idx := range list {
+		applications[idx], err = applicationFromResolver(ctx, d.root, list[idx])
+		if err != nil {
+			return err
+		}
+	}
+	err = archive.writeJSON("applications.json", applications)
+	if err != nil {
+		return err
+	}
+	profileList, err := d.root.Query().ApplicationProfiles(ctx)
+	if err != nil {
+		return err
+	}
+	profiles := make([]ApplicationProfile, len(profileList))
+	for idx := range profileList {
+		profiles[idx], err = applicationProfileFromResolver(ctx, d.root, profileList[idx])
+		if err != nil {
+			return err
+		}
+	}
+	err = archive.writeJSON("application-profiles.json", profiles)
+	if err != nil {
+		return err
+	}
+	topicList, err := d.root.Query().Topics(ctx, nil)
+	if err != nil {
+		return err
+	}
+	topics := make([]Topic, len(topicList))
+	for idx := range topicList {
+		topics[idx], err = topicFromResolver(ctx, d.root, topicList[idx])
+		if err != nil {
+			return err
+		}
+	}
+	err = archive.writeJSON("topics.json", topics)
+	if err != nil {
+		return err
+	}
+	authContext := auth.Informations(ctx)
+	for _, application := range applications {
+		pattern, err := applicationPattern(authContext.AccountID, application.ID, nil)
+		if err != nil {
+			return err
+		}
+		stream, err := d.nest.GetTopics(ctx, &nest.GetTopicsRequest{
+			Pattern:       pattern,
+			Watch:         false,
+			FromTimestamp: 0,
+		})
+		if err != nil {
+			return err
+		}
+		w, err := archive.create(fmt.Sprintf("records/%s.ndjson", application.ID))
+		if err != nil {
+			return err
+		}
+		// Retention policies are deliberately ignored: the takeout holds every record still stored for the account,
+		// including expired records nest cannot delete yet.
+		count, err := streamRecords(ctx, d.root, stream, &ndjsonRecordEncoder{w: json.NewEncoder(w)}, now, nil, nil, archive.flush)
+		if err != nil {
+			return fmt.Errorf("failed to export records of application %s after %d records: %w", application.ID, count, err)
+		}
+	}
+	return nil
+}
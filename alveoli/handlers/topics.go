@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+)
+
+func registerTopics(router *httprouter.Router, authProvider auth.Provider, root generated.ResolverRoot) {
+	topicHandler := &topics{root: root}
+	router.Handler(http.MethodGet, "/topics", authenticated(authProvider, topicHandler.List))
+}
+
+type topics struct {
+	root generated.ResolverRoot
+}
+
+func (d *topics) List(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	list, err := d.root.Query().Topics(r.Context(), optionalQueryParameter(r, "pattern"))
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	out := make([]Topic, len(list))
+	for idx := range list {
+		out[idx], err = topicFromResolver(r.Context(), d.root, list[idx])
+		if err != nil {
+			writeRPCError(w, err)
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
@@ -0,0 +1,87 @@
+// Package harness runs the alveoli HTTP API on top of in-memory backends, so the REST and GraphQL APIs can be
+// exercised end-to-end from tests.
+package harness
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/certificates"
+	"github.com/vx-labs/alveoli/alveoli/client"
+	"github.com/vx-labs/alveoli/alveoli/fakes"
+	"github.com/vx-labs/alveoli/alveoli/graph/resolvers"
+	"github.com/vx-labs/alveoli/alveoli/provisioning"
+	"github.com/vx-labs/alveoli/alveoli/server"
+	"github.com/vx-labs/alveoli/alveoli/store"
+	"github.com/vx-labs/alveoli/alveoli/usage"
+)
+
+// Backends groups the fake services used by a harness. They may be shared by several harnesses, to
+// check how accounts see each other's resources.
+type Backends struct {
+	Vespiary *fakes.Vespiary
+	Wasp     *fakes.Wasp
+	Nest     *fakes.Nest
+}
+
+// NewBackends returns empty fake services. Messages published on the broker are recorded in nest.
+func NewBackends() *Backends {
+	records := fakes.NewNest()
+	return &Backends{
+		Vespiary: fakes.NewVespiary(),
+		Wasp:     fakes.NewWasp(records),
+		Nest:     records,
+	}
+}
+
+// DeviceCertificatesSecret is the secret harnesses derive the keys of device certificate authorities from.
+const DeviceCertificatesSecret = "harness"
+
+// Harness is an alveoli HTTP server, authenticating all requests as a single account.
+type Harness struct {
+	*Backends
+	AccountID string
+	Server    *httptest.Server
+	client    *client.Client
+}
+
+// New starts a harness authenticated as accountID, on top of new backends and without quotas.
+func New(accountID string) *Harness {
+	return Start(accountID, NewBackends(), usage.Quotas{})
+}
+
+// Start starts a harness authenticated as accountID, on top of the provided backends.
+// The account is created in vespiary if needed.
+func Start(accountID string, backends *Backends, quotas usage.Quotas) *Harness {
+	backends.Vespiary.EnsureAccount(accountID, accountID)
+	authority := certificates.New(store.New(backends.Nest), []byte(DeviceCertificatesSecret))
+	root := resolvers.Root(backends.Wasp, backends.Vespiary, backends.Nest, usage.Limits{Default: quotas}, provisioning.Broker{Host: "localhost", Port: 1883}, authority)
+	authProvider := auth.Static(accountID, accountID)
+	srv := httptest.NewServer(server.Handler(authProvider, backends.Vespiary, backends.Nest, root, authority))
+	return &Harness{
+		Backends:  backends,
+		AccountID: accountID,
+		Server:    srv,
+		client:    client.New(srv.URL, accountID, srv.Client()),
+	}
+}
+
+// Close stops the HTTP server.
+func (h *Harness) Close() {
+	h.Server.Close()
+}
+
+// Do sends an authenticated request to the REST API, encoding body as JSON when it is not nil.
+func (h *Harness) Do(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	return h.client.Do(ctx, method, path, body)
+}
+
+// GraphQLError is returned when the GraphQL API answered with errors.
+type GraphQLError = client.GraphQLError
+
+// GraphQL runs query with the given variables, and decodes the response data in out when it is not nil.
+func (h *Harness) GraphQL(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
+	return h.client.GraphQL(ctx, query, variables, out)
+}
@@ -0,0 +1,310 @@
+package harness
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+// createApplication creates an application named name, and returns its ID.
+func createApplication(t *testing.T, h *Harness, name string) string {
+	t.Helper()
+	out := struct {
+		CreateApplication struct {
+			Application struct {
+				ID string `json:"id"`
+			} `json:"application"`
+			Success bool `json:"success"`
+		} `json:"createApplication"`
+	}{}
+	err := h.GraphQL(context.Background(), `mutation($name: String!) {
+		createApplication(input: {name: $name}) { application { id } success }
+	}`, map[string]interface{}{"name": name}, &out)
+	if err != nil {
+		t.Fatalf("failed to create application %q: %v", name, err)
+	}
+	if !out.CreateApplication.Success || out.CreateApplication.Application.ID == "" {
+		t.Fatalf("application %q was not created: %+v", name, out)
+	}
+	return out.CreateApplication.Application.ID
+}
+
+func TestGraphQLMutationAndQuery(t *testing.T) {
+	h := New("account")
+	defer h.Close()
+
+	id := createApplication(t, h, "greenhouse")
+
+	out := struct {
+		Applications []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"applications"`
+	}{}
+	err := h.GraphQL(context.Background(), `{ applications { id name } }`, nil, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Applications) != 1 || out.Applications[0].ID != id || out.Applications[0].Name != "greenhouse" {
+		t.Fatalf("unexpected applications: %+v", out.Applications)
+	}
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	h := New("account")
+	defer h.Close()
+
+	err := h.GraphQL(context.Background(), `{ application(id: "missing") { id } }`, nil, nil)
+	if _, ok := err.(*GraphQLError); !ok {
+		t.Fatalf("expected a GraphQL error, got %v", err)
+	}
+}
+
+func TestNestWatchStream(t *testing.T) {
+	h := New("account")
+	defer h.Close()
+	id := createApplication(t, h, "greenhouse")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	topic := tenancy.Topic(h.AccountID, id, "sensors/temperature")
+	_, err := h.Nest.PutRecords(ctx, &nest.PutRecordsRequest{Records: []*nest.Record{
+		{Timestamp: time.Now().UnixNano(), Topic: topic, Payload: []byte("20")},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream, err := h.Nest.GetTopics(ctx, &nest.GetTopicsRequest{
+		Pattern: tenancy.TopicPattern(h.AccountID, id, "#"),
+		Watch:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msg.Records) != 1 || string(msg.Records[0].Payload) != "20" {
+		t.Fatalf("unexpected stored records: %+v", msg.Records)
+	}
+
+	_, err = h.Nest.PutRecords(ctx, &nest.PutRecordsRequest{Records: []*nest.Record{
+		{Timestamp: time.Now().UnixNano(), Topic: topic, Payload: []byte("21")},
+		{Timestamp: time.Now().UnixNano(), Topic: tenancy.Topic(h.AccountID, "other", "sensors/temperature"), Payload: []byte("0")},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err = stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msg.Records) != 1 || string(msg.Records[0].Payload) != "21" {
+		t.Fatalf("unexpected watched records: %+v", msg.Records)
+	}
+
+	cancel()
+	if _, err := stream.Recv(); err == nil {
+		t.Fatal("expected the stream to end with its context")
+	}
+}
+
+func TestRecordStream(t *testing.T) {
+	h := New("account")
+	defer h.Close()
+	id := createApplication(t, h, "greenhouse")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.Do(ctx, http.MethodGet, "/applications/"+id+"/records/stream", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code %d", resp.StatusCode)
+	}
+	_, err = h.Nest.PutRecords(ctx, &nest.PutRecordsRequest{Records: []*nest.Record{
+		{Timestamp: time.Now().UnixNano(), Topic: tenancy.Topic(h.AccountID, id, "sensors/temperature"), Payload: []byte("21")},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		if !strings.Contains(line, "sensors/temperature") {
+			t.Fatalf("unexpected event: %s", line)
+		}
+		return
+	}
+	t.Fatalf("stream ended without records: %v", scanner.Err())
+}
+
+// readEvents returns the IDs and data of the next count record events sent on an SSE response.
+func readEvents(t *testing.T, scanner *bufio.Scanner, count int) ([]string, []string) {
+	t.Helper()
+	ids, data := []string{}, []string{}
+	for len(data) < count && scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "id: "):
+			ids = append(ids, strings.TrimPrefix(line, "id: "))
+		case strings.HasPrefix(line, "data: "):
+			data = append(data, strings.TrimPrefix(line, "data: "))
+		}
+	}
+	if len(data) < count {
+		t.Fatalf("stream ended after %d events: %v", len(data), scanner.Err())
+	}
+	return ids, data
+}
+
+func TestRecordStreamResume(t *testing.T) {
+	h := New("account")
+	defer h.Close()
+	id := createApplication(t, h, "greenhouse")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	topic := tenancy.Topic(h.AccountID, id, "sensors/temperature")
+
+	open := func(lastEventID string) *http.Response {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.Server.URL+"/applications/"+id+"/records/stream", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+h.AccountID)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		resp, err := h.Server.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code %d", resp.StatusCode)
+		}
+		return resp
+	}
+
+	resp := open("")
+	defer resp.Body.Close()
+	now := time.Now()
+	// The second record is stored after the first one, but with an older timestamp, as sent by a device with a
+	// late clock: it must be neither skipped nor sent twice when resuming after the first record.
+	for idx, record := range []*nest.Record{
+		{Timestamp: now.UnixNano(), Topic: topic, Payload: []byte("20")},
+		{Timestamp: now.Add(-time.Minute).UnixNano(), Topic: topic, Payload: []byte("21")},
+		{Timestamp: now.UnixNano(), Topic: topic, Payload: []byte("22")},
+	} {
+		_, err := h.Nest.PutRecords(ctx, &nest.PutRecordsRequest{Records: []*nest.Record{record}})
+		if err != nil {
+			t.Fatalf("failed to store record %d: %v", idx, err)
+		}
+	}
+	ids, data := readEvents(t, bufio.NewScanner(resp.Body), 3)
+	for idx, payload := range []string{"20", "21", "22"} {
+		if !strings.Contains(data[idx], `"payload":"`+payload+`"`) {
+			t.Fatalf("unexpected event %d: %s", idx, data[idx])
+		}
+	}
+
+	resumed := open(ids[0])
+	defer resumed.Body.Close()
+	resumedIDs, data := readEvents(t, bufio.NewScanner(resumed.Body), 2)
+	if resumedIDs[0] != ids[1] || resumedIDs[1] != ids[2] {
+		t.Fatalf("unexpected resumed event IDs %v, expected %v", resumedIDs, ids[1:])
+	}
+	if !strings.Contains(data[0], `"payload":"21"`) || !strings.Contains(data[1], `"payload":"22"`) {
+		t.Fatalf("unexpected resumed events: %v", data)
+	}
+}
+
+func TestRetentionSizeLimit(t *testing.T) {
+	h := New("account")
+	defer h.Close()
+	id := createApplication(t, h, "greenhouse")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := h.GraphQL(ctx, `mutation($applicationId: ID!) {
+		setRetentionPolicy(input: {applicationId: $applicationId, pattern: "sensors/#", maxSizeInBytes: 4}) { success }
+	}`, map[string]interface{}{"applicationId": id}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	// The newest record is stored first: the size limit must keep the newest records, not the last stored ones.
+	_, err = h.Nest.PutRecords(ctx, &nest.PutRecordsRequest{Records: []*nest.Record{
+		{Timestamp: now.UnixNano(), Topic: tenancy.Topic(h.AccountID, id, "sensors/temperature"), Payload: []byte("22")},
+		{Timestamp: now.Add(-2 * time.Second).UnixNano(), Topic: tenancy.Topic(h.AccountID, id, "sensors/temperature"), Payload: []byte("20")},
+		{Timestamp: now.Add(-time.Second).UnixNano(), Topic: tenancy.Topic(h.AccountID, id, "sensors/humidity"), Payload: []byte("55")},
+		{Timestamp: now.Add(-time.Hour).UnixNano(), Topic: tenancy.Topic(h.AccountID, id, "status"), Payload: []byte("online")},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := struct {
+		Application struct {
+			Records []struct {
+				Payload string `json:"payload"`
+			} `json:"records"`
+		} `json:"application"`
+	}{}
+	err = h.GraphQL(ctx, `query($id: ID!) { application(id: $id) { records { payload } } }`, map[string]interface{}{"id": id}, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payloads := []string{}
+	for _, record := range out.Application.Records {
+		payloads = append(payloads, record.Payload)
+	}
+	if strings.Join(payloads, ",") != "22,55,online" {
+		t.Fatalf("unexpected records: %v", payloads)
+	}
+}
+
+func TestDeleteApplicationForce(t *testing.T) {
+	h := New("account")
+	defer h.Close()
+	id := createApplication(t, h, "greenhouse")
+	ctx := context.Background()
+	err := h.GraphQL(ctx, `mutation($applicationId: String!) {
+		createApplicationProfile(input: {name: "sensors", applicationId: $applicationId, password: "password"}) { success }
+	}`, map[string]interface{}{"applicationId": id}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := h.Do(ctx, http.MethodDelete, "/applications/"+id, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected applications with profiles to be kept, got status code %d", resp.StatusCode)
+	}
+	err = h.GraphQL(ctx, `mutation($id: ID!) { deleteApplication(id: $id, force: true) }`, map[string]interface{}{"id": id}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	profiles, err := h.Vespiary.ListApplicationProfilesByAccountID(ctx, &vespiary.ListApplicationProfilesByAccountIDRequest{AccountID: h.AccountID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles.ApplicationProfiles) != 0 {
+		t.Fatalf("expected the application profiles to be deleted, got %d", len(profiles.ApplicationProfiles))
+	}
+}
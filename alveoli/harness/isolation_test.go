@@ -0,0 +1,309 @@
+package harness
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	"github.com/vx-labs/alveoli/alveoli/usage"
+	nest "github.com/vx-labs/nest/nest/api"
+	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+)
+
+// ownedResources are the resources created by the owner account of an isolation test.
+type ownedResources struct {
+	applicationID        string
+	applicationProfileID string
+	// deviceID is the ID of the session connected with the profile, as exposed by the API.
+	deviceID     string
+	serialNumber string
+}
+
+// provision creates an application, a profile, a record, a retained message, a session and a device certificate
+// for the account of h.
+func provision(t *testing.T, h *Harness) ownedResources {
+	t.Helper()
+	ctx := context.Background()
+	out := ownedResources{applicationID: createApplication(t, h, "greenhouse")}
+
+	profile := struct {
+		CreateApplicationProfile struct {
+			ApplicationProfile struct {
+				ID string `json:"id"`
+			} `json:"applicationProfile"`
+		} `json:"createApplicationProfile"`
+	}{}
+	err := h.GraphQL(ctx, `mutation($applicationId: String!) {
+		createApplicationProfile(input: {name: "sensors", applicationId: $applicationId, password: "password"}) { applicationProfile { id } }
+	}`, map[string]interface{}{"applicationId": out.applicationID}, &profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out.applicationProfileID = profile.CreateApplicationProfile.ApplicationProfile.ID
+
+	_, err = h.Nest.PutRecords(ctx, &nest.PutRecordsRequest{Records: []*nest.Record{
+		{Timestamp: time.Now().Add(-time.Minute).UnixNano(), Topic: tenancy.Topic(h.AccountID, out.applicationID, "sensors/temperature"), Payload: []byte(`{"value": 20}`)},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.GraphQL(ctx, `mutation($applicationId: ID!) {
+		setRetainedMessage(input: {applicationId: $applicationId, topicName: "sensors/status", payload: "online"}) { retainedMessage { topicName } }
+	}`, map[string]interface{}{"applicationId": out.applicationID}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out.deviceID = "device"
+	h.Wasp.Connect(&wasp.SessionMetadatas{
+		SessionID:  tenancy.SessionID("greenhouse", "sensors", out.deviceID),
+		MountPoint: tenancy.MountPoint(h.AccountID, out.applicationID),
+	})
+
+	resp, err := h.Do(ctx, http.MethodPost, "/device-certificates", map[string]string{"applicationProfileId": out.applicationProfileID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("failed to issue device certificate: status code %d", resp.StatusCode)
+	}
+	issued := struct {
+		DeviceCertificate struct {
+			SerialNumber string `json:"serialNumber"`
+		} `json:"deviceCertificate"`
+	}{}
+	err = json.NewDecoder(resp.Body).Decode(&issued)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out.serialNumber = issued.DeviceCertificate.SerialNumber
+	return out
+}
+
+// startAccounts starts two harnesses sharing the same backends: the first one owns provisioned resources, and the
+// second one must not see them.
+func startAccounts(t *testing.T) (*Harness, *Harness, ownedResources) {
+	backends := NewBackends()
+	owner := Start("owner", backends, usage.Quotas{})
+	other := Start("other", backends, usage.Quotas{})
+	return owner, other, provision(t, owner)
+}
+
+func TestGraphQLIsolation(t *testing.T) {
+	owner, other, owned := startAccounts(t)
+	defer owner.Close()
+	defer other.Close()
+	ctx := context.Background()
+	variables := map[string]interface{}{
+		"applicationId":        owned.applicationID,
+		"applicationProfileId": owned.applicationProfileID,
+		"serialNumber":         owned.serialNumber,
+	}
+
+	// Queries on the resources of the owner must fail, or return nothing, while they succeed for the owner.
+	for name, query := range map[string]string{
+		"application":         `query($applicationId: ID!) { application(id: $applicationId) { id } }`,
+		"application records": `query($applicationId: ID!) { application(id: $applicationId) { records { topicName } } }`,
+		"application stats":   `query($applicationId: ID!) { application(id: $applicationId) { stats { messageCount } } }`,
+		"application profile": `query($applicationProfileId: ID!) { applicationProfile(id: $applicationProfileId) { id } }`,
+		"topic tree":          `query($applicationId: ID!) { topicTree(applicationId: $applicationId) { children { name } } }`,
+		"search records":      `query($applicationId: ID!) { searchRecords(applicationId: $applicationId, query: "value") { matches { record { topicName } } } }`,
+	} {
+		out := map[string]interface{}{}
+		err := owner.GraphQL(ctx, query, variables, &out)
+		if err != nil || len(out) == 0 {
+			t.Errorf("%s: the owner got %v: %v", name, out, err)
+		}
+		out = map[string]interface{}{}
+		err = other.GraphQL(ctx, query, variables, &out)
+		if _, ok := err.(*GraphQLError); err != nil && !ok {
+			t.Errorf("%s: %v", name, err)
+		}
+		if err == nil {
+			for field, value := range out {
+				if value != nil {
+					t.Errorf("%s: the other account got %s: %v", name, field, value)
+				}
+			}
+		}
+	}
+
+	// Listings must be empty.
+	for name, query := range map[string]string{
+		"applications":         `{ applications { id } }`,
+		"application profiles": `{ applicationProfiles { id } }`,
+		"topics":               `{ topics { name } }`,
+		"all topics":           `{ topics(pattern: "#") { name } }`,
+		"sessions":             `{ sessions { id } }`,
+		"device certificates":  `{ deviceCertificates { serialNumber } }`,
+	} {
+		out := map[string][]interface{}{}
+		err := other.GraphQL(ctx, query, nil, &out)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		for field, values := range out {
+			if len(values) > 0 {
+				t.Errorf("%s: the other account got %s: %v", name, field, values)
+			}
+		}
+	}
+
+	// Mutations on the resources of the owner must fail.
+	for name, query := range map[string]string{
+		"delete application":         `mutation($applicationId: ID!) { deleteApplication(id: $applicationId, force: true) }`,
+		"delete application profile": `mutation($applicationProfileId: ID!) { deleteApplicationProfile(id: $applicationProfileId) }`,
+		"issue device certificate":   `mutation($applicationProfileId: ID!) { issueDeviceCertificate(applicationProfileId: $applicationProfileId) { success } }`,
+		"revoke device certificate":  `mutation($serialNumber: ID!) { revokeDeviceCertificate(serialNumber: $serialNumber) { serialNumber } }`,
+		"set retained message":       `mutation($applicationId: ID!) { setRetainedMessage(input: {applicationId: $applicationId, topicName: "sensors/status", payload: "offline"}) { retainedMessage { topicName } } }`,
+		"clear retained message":     `mutation($applicationId: ID!) { clearRetainedMessage(applicationId: $applicationId, topicName: "sensors/status") }`,
+	} {
+		err := other.GraphQL(ctx, query, variables, nil)
+		if _, ok := err.(*GraphQLError); !ok {
+			t.Errorf("%s: expected a GraphQL error, got %v", name, err)
+		}
+	}
+
+	// The resources of the owner are left untouched.
+	out := struct {
+		Application struct {
+			Profiles []struct {
+				ID string `json:"id"`
+			} `json:"profiles"`
+			Topics []struct {
+				Name            string `json:"name"`
+				RetainedMessage *struct {
+					Payload string `json:"payload"`
+				} `json:"retainedMessage"`
+			} `json:"topics"`
+		} `json:"application"`
+		DeviceCertificates []struct {
+			RevokedAt *string `json:"revokedAt"`
+		} `json:"deviceCertificates"`
+	}{}
+	err := owner.GraphQL(ctx, `query($applicationId: ID!) {
+		application(id: $applicationId) { profiles { id } topics { name retainedMessage { payload } } }
+		deviceCertificates { revokedAt }
+	}`, map[string]interface{}{"applicationId": owned.applicationID}, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Application.Profiles) != 1 {
+		t.Errorf("the owner lost its profile: %+v", out.Application.Profiles)
+	}
+	retained := ""
+	for _, topic := range out.Application.Topics {
+		if topic.Name == "sensors/status" && topic.RetainedMessage != nil {
+			retained = topic.RetainedMessage.Payload
+		}
+	}
+	if retained != "online" {
+		t.Errorf("the retained message of the owner was modified: %+v", out.Application.Topics)
+	}
+	if len(out.DeviceCertificates) != 1 || out.DeviceCertificates[0].RevokedAt != nil {
+		t.Errorf("the device certificate of the owner was modified: %+v", out.DeviceCertificates)
+	}
+}
+
+func TestRESTIsolation(t *testing.T) {
+	owner, other, owned := startAccounts(t)
+	defer owner.Close()
+	defer other.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	application := "/applications/" + owned.applicationID
+
+	// Routes addressing the resources of the owner must not find them, while they are readable by the owner.
+	for _, route := range []struct {
+		method string
+		path   string
+		body   interface{}
+	}{
+		{method: http.MethodGet, path: application},
+		{method: http.MethodGet, path: application + "/profiles"},
+		{method: http.MethodGet, path: application + "/topics"},
+		{method: http.MethodGet, path: application + "/topics/sensors/temperature"},
+		{method: http.MethodGet, path: application + "/records"},
+		{method: http.MethodGet, path: application + "/records/stream"},
+		{method: http.MethodGet, path: application + "/records/export"},
+		{method: http.MethodDelete, path: application},
+		{method: http.MethodDelete, path: application + "?force=true"},
+		{method: http.MethodGet, path: "/application-profiles/" + owned.applicationProfileID},
+		{method: http.MethodDelete, path: "/application-profiles/" + owned.applicationProfileID},
+		{method: http.MethodGet, path: "/sessions/" + owned.deviceID},
+		{method: http.MethodPost, path: "/device-certificates", body: map[string]string{"applicationProfileId": owned.applicationProfileID}},
+		{method: http.MethodPost, path: "/device-certificates/" + owned.serialNumber + "/revoke"},
+	} {
+		if route.method == http.MethodGet {
+			resp, err := owner.Do(ctx, route.method, route.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("%s %s: the owner got status code %d", route.method, route.path, resp.StatusCode)
+			}
+		}
+		resp, err := other.Do(ctx, route.method, route.path, route.body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("%s %s: expected status code %d, got %d", route.method, route.path, http.StatusNotFound, resp.StatusCode)
+		}
+	}
+
+	// Listings must be empty.
+	for _, path := range []string{"/applications", "/application-profiles", "/topics", "/sessions", "/device-certificates"} {
+		resp, err := other.Do(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values := []interface{}{}
+		err = json.NewDecoder(resp.Body).Decode(&values)
+		resp.Body.Close()
+		if err != nil {
+			t.Errorf("GET %s: %v", path, err)
+			continue
+		}
+		if len(values) > 0 {
+			t.Errorf("GET %s: the other account got %v", path, values)
+		}
+	}
+
+	// The account export only holds the resources of the other account.
+	resp, err := other.Do(ctx, http.MethodGet, "/account/export", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{owned.applicationID, owned.applicationProfileID, owned.serialNumber} {
+		if strings.Contains(string(body), id) {
+			t.Errorf("the account export of the other account holds %s", id)
+		}
+	}
+
+	// The resources of the owner are left untouched.
+	for _, path := range []string{application, "/application-profiles/" + owned.applicationProfileID} {
+		resp, err := owner.Do(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("GET %s: the owner got status code %d", path, resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package harness
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+)
+
+func TestApplicationProfileValidation(t *testing.T) {
+	h := New("account")
+	defer h.Close()
+	id := createApplication(t, h, "greenhouse")
+	ctx := context.Background()
+	create := func(name, password string) error {
+		return h.GraphQL(ctx, `mutation($applicationId: String!, $name: String!, $password: String!) {
+			createApplicationProfile(input: {name: $name, applicationId: $applicationId, password: $password}) { success }
+		}`, map[string]interface{}{"applicationId": id, "name": name, "password": password}, nil)
+	}
+	if err := create("sensors", "password"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := create("short", "secret").(*GraphQLError); !ok {
+		t.Error("expected passwords shorter than 8 characters to be rejected")
+	}
+	if _, ok := create("sensors", "password").(*GraphQLError); !ok {
+		t.Error("expected duplicate profile names to be rejected")
+	}
+}
+
+func TestSessionApplicationProfile(t *testing.T) {
+	h := New("account")
+	defer h.Close()
+	applicationID := createApplication(t, h, "greenhouse")
+	ctx := context.Background()
+	profile := struct {
+		CreateApplicationProfile struct {
+			ApplicationProfile struct {
+				ID         string `json:"id"`
+				Connection struct {
+					Username string `json:"username"`
+				} `json:"connection"`
+			} `json:"applicationProfile"`
+		} `json:"createApplicationProfile"`
+	}{}
+	err := h.GraphQL(ctx, `mutation($applicationId: String!) {
+		createApplicationProfile(input: {name: "sensors", applicationId: $applicationId, password: "password"}) { applicationProfile { id connection { username } } }
+	}`, map[string]interface{}{"applicationId": applicationID}, &profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	username := profile.CreateApplicationProfile.ApplicationProfile.Connection.Username
+	if username != "account/greenhouse/sensors" {
+		t.Fatalf("unexpected username %q", username)
+	}
+
+	if _, _, err := h.Vespiary.Authenticate([]byte(username), []byte("wrong password")); err == nil {
+		t.Fatal("expected a wrong password to be rejected")
+	}
+	sessionID, mountPoint, err := h.Vespiary.Authenticate([]byte(username), []byte("password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mountPoint != h.AccountID+"/"+applicationID || !strings.HasPrefix(sessionID, "greenhouse/sensors/") {
+		t.Fatalf("unexpected session %q mounted on %q", sessionID, mountPoint)
+	}
+	h.Wasp.Connect(&wasp.SessionMetadatas{SessionID: sessionID, MountPoint: tenancy.Root + "/" + mountPoint})
+
+	out := struct {
+		Sessions []struct {
+			ID                   string `json:"id"`
+			ApplicationProfileID string `json:"applicationProfileId"`
+		} `json:"sessions"`
+	}{}
+	err = h.GraphQL(ctx, `{ sessions { id applicationProfileId } }`, nil, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Sessions) != 1 || out.Sessions[0].ApplicationProfileID != profile.CreateApplicationProfile.ApplicationProfile.ID ||
+		out.Sessions[0].ID != strings.TrimPrefix(sessionID, "greenhouse/sensors/") {
+		t.Fatalf("unexpected sessions: %+v", out.Sessions)
+	}
+}
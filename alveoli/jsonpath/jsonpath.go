@@ -0,0 +1,139 @@
+package jsonpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type step struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// Path is a parsed JSONPath expression. Only the child operators are supported:
+// "$.a.b", "$['a'][0]" and "a.b[0]" are valid paths.
+type Path struct {
+	expr  string
+	steps []step
+}
+
+// Parse parses a JSONPath expression. The leading "$" is optional.
+func Parse(expr string) (Path, error) {
+	out := Path{expr: expr}
+	rest := strings.TrimSpace(expr)
+	if strings.HasPrefix(rest, "$") {
+		rest = rest[1:]
+	} else if rest != "" && rest[0] != '.' && rest[0] != '[' {
+		rest = "." + rest
+	}
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			key := rest[:end]
+			if key == "" {
+				return Path{}, fmt.Errorf("invalid path %q: empty field name", expr)
+			}
+			if key == "*" {
+				return Path{}, fmt.Errorf("invalid path %q: wildcards are not supported", expr)
+			}
+			out.steps = append(out.steps, step{key: key})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return Path{}, fmt.Errorf("invalid path %q: missing closing bracket", expr)
+			}
+			selector := rest[1:end]
+			rest = rest[end+1:]
+			if len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0] {
+				out.steps = append(out.steps, step{key: selector[1 : len(selector)-1]})
+				continue
+			}
+			index, err := strconv.Atoi(selector)
+			if err != nil || index < 0 {
+				return Path{}, fmt.Errorf("invalid path %q: unsupported selector %q", expr, selector)
+			}
+			out.steps = append(out.steps, step{index: index, isIndex: true})
+		default:
+			return Path{}, fmt.Errorf("invalid path %q: unexpected character %q", expr, rest[0])
+		}
+	}
+	return out, nil
+}
+
+// String returns the expression the path was parsed from.
+func (p Path) String() string {
+	return p.expr
+}
+
+// Lookup returns the value found at the path in v, a value decoded by encoding/json.
+func (p Path) Lookup(v interface{}) (interface{}, bool) {
+	current := v
+	for _, s := range p.steps {
+		if s.isIndex {
+			list, ok := current.([]interface{})
+			if !ok || s.index >= len(list) {
+				return nil, false
+			}
+			current = list[s.index]
+			continue
+		}
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[s.key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// Number returns the numeric value found at the path in v.
+func (p Path) Number(v interface{}) (float64, bool) {
+	value, ok := p.Lookup(v)
+	if !ok {
+		return 0, false
+	}
+	return ToNumber(value)
+}
+
+// ToNumber converts numeric values produced by the JSON, CBOR and MessagePack decoders into a float64.
+func ToNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
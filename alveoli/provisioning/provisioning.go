@@ -0,0 +1,80 @@
+// Package provisioning builds the credentials and connection details devices need to reach the MQTT broker.
+package provisioning
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// passwordBytes is the amount of random bytes in generated passwords.
+const passwordBytes = 24
+
+// Broker is the MQTT endpoint devices connect to.
+type Broker struct {
+	Host string
+	Port int
+	TLS  bool
+	// ServerName is the name devices must send in the TLS handshake.
+	ServerName string
+}
+
+// Connection holds the details a device needs to connect with an application profile.
+type Connection struct {
+	Broker
+	Username string
+	// ClientIDHint shows the expected form of client IDs: each device must use its own.
+	ClientIDHint string
+	// MountPoint is the prefix the broker adds to the topics used by devices.
+	MountPoint string
+}
+
+// GeneratePassword returns a random password, encoded so it can be used in MQTT clients and shells without escaping.
+func GeneratePassword() (string, error) {
+	buf := make([]byte, passwordBytes)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Error is returned when a name cannot be part of an MQTT username.
+type Error struct {
+	Kind string
+	Name string
+}
+
+func (e *Error) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("%s name must not be empty", e.Kind)
+	}
+	return fmt.Sprintf("invalid %s name %q: names must not contain /", e.Kind, e.Name)
+}
+
+// ValidateName returns an error if name, identifying a resource of the given kind, cannot be part of an MQTT username.
+func ValidateName(kind, name string) error {
+	if name == "" || strings.Contains(name, "/") {
+		return &Error{Kind: kind, Name: name}
+	}
+	return nil
+}
+
+// Username returns the MQTT username of a profile, which devices use with the profile password.
+// The broker splits usernames on /, and resolves the account using its name.
+func Username(accountName, applicationName, profileName string) (string, error) {
+	for _, name := range []struct{ kind, value string }{
+		{"account", accountName}, {"application", applicationName}, {"application profile", profileName},
+	} {
+		if err := ValidateName(name.kind, name.value); err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("%s/%s/%s", accountName, applicationName, profileName), nil
+}
+
+// ClientIDHint returns an example of client ID for the devices of a profile.
+func ClientIDHint(profileName string) string {
+	return fmt.Sprintf("%s-<device serial number>", profileName)
+}
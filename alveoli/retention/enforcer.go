@@ -0,0 +1,194 @@
+package retention
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/store"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	nest "github.com/vx-labs/nest/nest/api"
+	"go.uber.org/zap"
+)
+
+// Report describes the data of an application exceeding one of its retention policies.
+type Report struct {
+	AccountID      string
+	ApplicationID  string
+	Pattern        string
+	ExpiredRecords int
+	ExpiredBytes   int
+	ExcessBytes    int
+}
+
+// Enforcer periodically checks the stored records of all accounts against their retention policies.
+//
+// The enforcer purges nothing: nest cannot delete records, so it only measures and logs the data
+// exceeding the policies, which reads already hide. Expired data is computed from the topics metadata and the records
+// still covered by the policies, so that the full history is never streamed from nest.
+type Enforcer struct {
+	store  *store.Store
+	nest   nest.MessagesClient
+	logger *zap.Logger
+}
+
+// NewEnforcer returns an enforcer using the policies found in s.
+func NewEnforcer(s *store.Store, nestClient nest.MessagesClient, logger *zap.Logger) *Enforcer {
+	return &Enforcer{store: s, nest: nestClient, logger: logger}
+}
+
+// Run enforces policies every interval, until ctx is cancelled.
+func (e *Enforcer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		reports, err := e.Enforce(ctx)
+		if err != nil {
+			e.logger.Warn("failed to enforce retention policies", zap.Error(err))
+		}
+		for _, report := range reports {
+			e.logger.Warn("records exceed retention policy, but the storage backend does not support deletion",
+				zap.String("account_id", report.AccountID),
+				zap.String("application_id", report.ApplicationID),
+				zap.String("pattern", report.Pattern),
+				zap.Int("expired_records", report.ExpiredRecords),
+				zap.Int("expired_bytes", report.ExpiredBytes),
+				zap.Int("excess_bytes", report.ExcessBytes),
+			)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Enforce checks all accounts once, and returns the policies being exceeded.
+// Failing applications are logged and skipped, so that one of them cannot prevent the others from being checked.
+func (e *Enforcer) Enforce(ctx context.Context) ([]Report, error) {
+	accounts, err := e.store.ListAccounts(ctx, StoreKind)
+	if err != nil {
+		return nil, err
+	}
+	out := []Report{}
+	for accountID, values := range accounts {
+		applications := map[string][]Policy{}
+		for _, policy := range decode(values) {
+			applications[policy.ApplicationID] = append(applications[policy.ApplicationID], policy)
+		}
+		for applicationID, policies := range applications {
+			reports, err := e.enforceApplication(ctx, accountID, applicationID, policies)
+			if err != nil {
+				if ctx.Err() != nil {
+					return out, ctx.Err()
+				}
+				e.logger.Warn("failed to enforce application retention policies",
+					zap.String("account_id", accountID),
+					zap.String("application_id", applicationID),
+					zap.Error(err),
+				)
+				continue
+			}
+			out = append(out, reports...)
+		}
+	}
+	return out, nil
+}
+
+func (e *Enforcer) enforceApplication(ctx context.Context, accountID, applicationID string, policies []Policy) ([]Report, error) {
+	prefix := tenancy.TopicPrefix(accountID, applicationID)
+	now := time.Now()
+	reports := make(map[string]*Report, len(policies))
+	report := func(policy *Policy) *Report {
+		if reports[policy.Pattern] == nil {
+			reports[policy.Pattern] = &Report{AccountID: accountID, ApplicationID: applicationID, Pattern: policy.Pattern}
+		}
+		return reports[policy.Pattern]
+	}
+
+	topicsList, err := e.nest.ListTopics(ctx, &nest.ListTopicsRequest{Pattern: []byte(prefix + "#")})
+	if err != nil {
+		return nil, err
+	}
+	sizes := map[string]int{}
+	stored := map[string]*topicUsage{}
+	for _, metadata := range topicsList.TopicMetadatas {
+		policy := Find(policies, strings.TrimPrefix(string(metadata.Name), prefix))
+		if policy == nil {
+			continue
+		}
+		if policy.MaxSizeInBytes > 0 {
+			sizes[policy.Pattern] += int(metadata.SizeInBytes)
+		}
+		if policy.RetentionSeconds > 0 {
+			stored[string(metadata.Name)] = &topicUsage{policy: policy, records: int(metadata.MessageCount), bytes: int(metadata.SizeInBytes)}
+		}
+	}
+	for idx := range policies {
+		policy := &policies[idx]
+		if excess := sizes[policy.Pattern] - int(policy.MaxSizeInBytes); policy.MaxSizeInBytes > 0 && excess > 0 {
+			report(policy).ExcessBytes = excess
+		}
+	}
+	if len(stored) == 0 {
+		return e.reports(policies, reports), nil
+	}
+
+	// Only stream the records still covered by the policies: expired data is what remains
+	// of the stored topics once they are subtracted.
+	stream, err := e.nest.GetTopics(ctx, &nest.GetTopicsRequest{
+		Pattern:       []byte(prefix + "#"),
+		Watch:         false,
+		FromTimestamp: Window(policies, now).UnixNano(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range msg.Records {
+			usage, ok := stored[string(record.Topic)]
+			if !ok || !Retained(policies, strings.TrimPrefix(string(record.Topic), prefix), record.Timestamp, now) {
+				continue
+			}
+			usage.records--
+			usage.bytes -= len(record.Payload)
+		}
+	}
+	for _, usage := range stored {
+		if usage.records <= 0 {
+			continue
+		}
+		r := report(usage.policy)
+		r.ExpiredRecords += usage.records
+		if usage.bytes > 0 {
+			r.ExpiredBytes += usage.bytes
+		}
+	}
+	return e.reports(policies, reports), nil
+}
+
+// topicUsage holds the records stored on a topic that are not known to be covered by its retention policy.
+type topicUsage struct {
+	policy  *Policy
+	records int
+	bytes   int
+}
+
+func (e *Enforcer) reports(policies []Policy, reports map[string]*Report) []Report {
+	out := make([]Report, 0, len(reports))
+	for _, policy := range policies {
+		if r, ok := reports[policy.Pattern]; ok {
+			out = append(out, *r)
+		}
+	}
+	return out
+}
@@ -0,0 +1,205 @@
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vx-labs/alveoli/alveoli/store"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	"github.com/vx-labs/alveoli/alveoli/topics"
+	nest "github.com/vx-labs/nest/nest/api"
+)
+
+const (
+	// StoreKind is the store namespace holding retention policies.
+	StoreKind = "retention-policies"
+	// DefaultPeriod is the record history shown for topics not covered by a retention policy.
+	DefaultPeriod = 15 * 24 * time.Hour
+)
+
+// Policy limits the records shown for the topics matching Pattern, inside an application.
+// A zero RetentionSeconds shows records for DefaultPeriod, and a zero MaxSizeInBytes does not limit their size.
+//
+// Nest cannot delete records: policies hide the records they do not cover, but nothing is ever purged from storage.
+type Policy struct {
+	ApplicationID    string `json:"applicationId"`
+	Pattern          string `json:"pattern"`
+	RetentionSeconds int64  `json:"retentionSeconds,omitempty"`
+	MaxSizeInBytes   int64  `json:"maxSizeInBytes,omitempty"`
+	// sizeCutoff is the timestamp before which records exceed MaxSizeInBytes, set by LoadEffective.
+	sizeCutoff int64
+}
+
+// Period returns the duration records are shown for.
+func (p Policy) Period() time.Duration {
+	if p.RetentionSeconds <= 0 {
+		return DefaultPeriod
+	}
+	return time.Duration(p.RetentionSeconds) * time.Second
+}
+
+// Key returns the store key of the policy attached to pattern in the given application.
+func Key(applicationID, pattern string) string {
+	return applicationID + "/" + pattern
+}
+
+// Load returns the retention policies of the given application, sorted by pattern.
+func Load(ctx context.Context, s *store.Store, accountID, applicationID string) ([]Policy, error) {
+	values, err := s.List(ctx, accountID, StoreKind)
+	if err != nil {
+		return nil, err
+	}
+	out := []Policy{}
+	for _, policy := range decode(values) {
+		if policy.ApplicationID == applicationID {
+			out = append(out, policy)
+		}
+	}
+	return out, nil
+}
+
+// LoadEffective returns the retention policies of the given application, with their size limits resolved against
+// the records stored in nest: Cutoff then also hides the oldest records of the topics exceeding MaxSizeInBytes.
+func LoadEffective(ctx context.Context, s *store.Store, nestClient nest.MessagesClient, accountID, applicationID string, now time.Time) ([]Policy, error) {
+	policies, err := Load(ctx, s, accountID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	return policies, applySizeLimits(ctx, nestClient, accountID, applicationID, policies, now)
+}
+
+// applySizeLimits sets the size cutoff of the policies whose topics exceed MaxSizeInBytes, so that only their newest
+// records fitting in MaxSizeInBytes are retained.
+func applySizeLimits(ctx context.Context, nestClient nest.MessagesClient, accountID, applicationID string, policies []Policy, now time.Time) error {
+	prefix := tenancy.TopicPrefix(accountID, applicationID)
+	topicsList, err := nestClient.ListTopics(ctx, &nest.ListTopicsRequest{Pattern: []byte(prefix + "#")})
+	if err != nil {
+		return err
+	}
+	// Topics metadata include the expired records: records are only streamed if they may exceed the limit.
+	sizes := map[*Policy]int64{}
+	for _, metadata := range topicsList.TopicMetadatas {
+		if policy := Find(policies, strings.TrimPrefix(string(metadata.Name), prefix)); policy != nil && policy.MaxSizeInBytes > 0 {
+			sizes[policy] += int64(metadata.SizeInBytes)
+		}
+	}
+	exceeding := false
+	for policy, size := range sizes {
+		if size > policy.MaxSizeInBytes {
+			exceeding = true
+		}
+	}
+	if !exceeding {
+		return nil
+	}
+	type recordSize struct {
+		timestamp int64
+		size      int64
+	}
+	records := map[*Policy][]recordSize{}
+	stream, err := nestClient.GetTopics(ctx, &nest.GetTopicsRequest{
+		Pattern:       []byte(prefix + "#"),
+		Watch:         false,
+		FromTimestamp: Window(policies, now).UnixNano(),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		for _, record := range msg.Records {
+			topic := strings.TrimPrefix(string(record.Topic), prefix)
+			policy := Find(policies, topic)
+			if policy == nil || sizes[policy] <= policy.MaxSizeInBytes || !Retained(policies, topic, record.Timestamp, now) {
+				continue
+			}
+			records[policy] = append(records[policy], recordSize{timestamp: record.Timestamp, size: int64(len(record.Payload))})
+		}
+	}
+	for policy, stored := range records {
+		// Nest delivers records in storage order, which may differ from their timestamp order.
+		sort.Slice(stored, func(i, j int) bool { return stored[i].timestamp > stored[j].timestamp })
+		total := int64(0)
+		for _, record := range stored {
+			total += record.size
+			if total > policy.MaxSizeInBytes {
+				policy.sizeCutoff = record.timestamp + 1
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func decode(values map[string][]byte) []Policy {
+	out := make([]Policy, 0, len(values))
+	for _, value := range values {
+		policy := Policy{}
+		if json.Unmarshal(value, &policy) == nil {
+			out = append(out, policy)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ApplicationID == out[j].ApplicationID {
+			return out[i].Pattern < out[j].Pattern
+		}
+		return out[i].ApplicationID < out[j].ApplicationID
+	})
+	return out
+}
+
+// Find returns the policy governing topic, or nil. When multiple patterns match topic,
+// the longest, most specific one is used.
+func Find(policies []Policy, topic string) *Policy {
+	var out *Policy
+	for idx := range policies {
+		if !topics.Match(policies[idx].Pattern, topic) {
+			continue
+		}
+		if out == nil || len(policies[idx].Pattern) > len(out.Pattern) {
+			out = &policies[idx]
+		}
+	}
+	return out
+}
+
+// Cutoff returns the time before which records published on topic must not be shown.
+func Cutoff(policies []Policy, topic string, now time.Time) time.Time {
+	policy := Find(policies, topic)
+	if policy == nil {
+		return now.Add(-DefaultPeriod)
+	}
+	out := now.Add(-policy.Period())
+	if policy.sizeCutoff > out.UnixNano() {
+		out = time.Unix(0, policy.sizeCutoff)
+	}
+	return out
+}
+
+// Retained reports whether a record published on topic at timestamp is still covered by policies.
+func Retained(policies []Policy, topic string, timestamp int64, now time.Time) bool {
+	return timestamp >= Cutoff(policies, topic, now).UnixNano()
+}
+
+// Window returns the earliest cutoff of policies, so that a single nest query can fetch the records
+// of all the application topics before filtering them using Cutoff.
+func Window(policies []Policy, now time.Time) time.Time {
+	out := now.Add(-DefaultPeriod)
+	for _, policy := range policies {
+		cutoff := now.Add(-policy.Period())
+		if cutoff.Before(out) {
+			out = cutoff
+		}
+	}
+	return out
+}
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/tls"
@@ -71,11 +72,7 @@ func loadTLSConfig(caPath, crtPath, keyPath string) *tls.Config {
 }
 
 func GenerateSelfSignedCertificate(cn string, san []string, ipAddresses []net.IP) (*tls.Certificate, error) {
-	privkey, err := rsa.GenerateKey(rand.Reader, 2048)
-	if err != nil {
-		return nil, err
-	}
-	certTemplate := &x509.Certificate{
+	return generateSelfSignedCertificate(&x509.Certificate{
 		NotAfter:     time.Now().Add(12 * 30 * 24 * time.Hour),
 		SerialNumber: big.NewInt(1),
 		IPAddresses:  ipAddresses,
@@ -85,8 +82,33 @@ func GenerateSelfSignedCertificate(cn string, san []string, ipAddresses []net.IP
 		},
 		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
 		KeyUsage:    x509.KeyUsageDigitalSignature,
+	})
+}
+
+// GenerateCertificateAuthority returns a certificate authority self-signed with key, able to sign certificates and
+// revocation lists until notAfter.
+func GenerateCertificateAuthority(subject pkix.Name, notAfter time.Time, key crypto.Signer) (*tls.Certificate, error) {
+	return signSelfSignedCertificate(&x509.Certificate{
+		NotBefore:             time.Now(),
+		NotAfter:              notAfter,
+		SerialNumber:          big.NewInt(1),
+		Subject:               subject,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		MaxPathLenZero:        true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+	}, key)
+}
+
+func generateSelfSignedCertificate(certTemplate *x509.Certificate) (*tls.Certificate, error) {
+	privkey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
 	}
+	return signSelfSignedCertificate(certTemplate, privkey)
+}
 
+func signSelfSignedCertificate(certTemplate *x509.Certificate, privkey crypto.Signer) (*tls.Certificate, error) {
 	certBody, err := x509.CreateCertificate(rand.Reader, certTemplate, certTemplate, privkey.Public(), privkey)
 	if err != nil {
 		return nil, err
@@ -145,7 +167,7 @@ func GRPCServerOptions(mTLS bool, tlsCertificateAuthorityPath, tlsCertificatePat
 				panic(err)
 			}
 		} else {
-			tlsCertificate, err := GenerateSelfSignedCertificate(os.Getenv("HOSTNAME"), []string{"*"}, append(ListLocalIP()))
+			tlsCertificate, err := GenerateSelfSignedCertificate(os.Getenv("HOSTNAME"), []string{"*"}, ListLocalIP())
 			if err != nil {
 				panic(err)
 			}
@@ -0,0 +1,105 @@
+package schemas
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vx-labs/alveoli/alveoli/decoding"
+	"github.com/vx-labs/alveoli/alveoli/topics"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Type is the kind of schema attached to a topic pattern.
+type Type string
+
+const (
+	JSONSchema Type = "JSON_SCHEMA"
+	Protobuf   Type = "PROTOBUF"
+)
+
+// Schema describes the expected payload of the topics matching Pattern, inside an application.
+type Schema struct {
+	ApplicationID string `json:"applicationId"`
+	Pattern       string `json:"pattern"`
+	Type          Type   `json:"type"`
+	JSONSchema    string `json:"jsonSchema,omitempty"`
+	MessageType   string `json:"messageType,omitempty"`
+}
+
+// Validator checks payloads against a compiled schema.
+type Validator struct {
+	Schema     Schema
+	jsonSchema *gojsonschema.Schema
+	message    protoreflect.MessageDescriptor
+}
+
+// Compile prepares a validator for the given schema.
+// files holds the application protobuf descriptors, and is only required for protobuf schemas.
+func Compile(schema Schema, files *protoregistry.Files) (*Validator, error) {
+	switch schema.Type {
+	case JSONSchema:
+		compiled, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(schema.JSONSchema))
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON schema: %w", err)
+		}
+		return &Validator{Schema: schema, jsonSchema: compiled}, nil
+	case Protobuf:
+		if files == nil {
+			return nil, errors.New("no protobuf descriptor set uploaded for this application")
+		}
+		message, err := decoding.FindMessage(files, schema.MessageType)
+		if err != nil {
+			return nil, err
+		}
+		return &Validator{Schema: schema, message: message}, nil
+	default:
+		return nil, fmt.Errorf("unsupported schema type %q", schema.Type)
+	}
+}
+
+// Message returns the protobuf message descriptor used by this validator, or nil for JSON schemas.
+func (v *Validator) Message() protoreflect.MessageDescriptor {
+	return v.message
+}
+
+// Validate returns the list of validation errors of payload. An empty list means payload is valid.
+func (v *Validator) Validate(payload []byte) []string {
+	switch v.Schema.Type {
+	case JSONSchema:
+		result, err := v.jsonSchema.Validate(gojsonschema.NewBytesLoader(payload))
+		if err != nil {
+			return []string{fmt.Sprintf("payload is not valid JSON: %v", err)}
+		}
+		out := make([]string, len(result.Errors()))
+		for idx, resultError := range result.Errors() {
+			out[idx] = resultError.String()
+		}
+		return out
+	case Protobuf:
+		msg := dynamicpb.NewMessage(v.message)
+		err := proto.Unmarshal(payload, msg)
+		if err != nil {
+			return []string{fmt.Sprintf("payload is not a valid %s message: %v", v.message.FullName(), err)}
+		}
+		if len(msg.GetUnknown()) > 0 {
+			return []string{fmt.Sprintf("payload contains fields unknown to %s", v.message.FullName())}
+		}
+		return []string{}
+	default:
+		return []string{fmt.Sprintf("unsupported schema type %q", v.Schema.Type)}
+	}
+}
+
+// Find returns the first validator whose pattern matches topic, or nil.
+func Find(validators []*Validator, topic string) *Validator {
+	for _, validator := range validators {
+		if topics.Match(validator.Schema.Pattern, topic) {
+			return validator
+		}
+	}
+	return nil
+}
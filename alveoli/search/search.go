@@ -0,0 +1,209 @@
+package search
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/vx-labs/alveoli/alveoli/decoding"
+	"github.com/vx-labs/alveoli/alveoli/jsonpath"
+)
+
+// Mode is the way a search query is interpreted.
+type Mode string
+
+const (
+	Substring Mode = "SUBSTRING"
+	Regex     Mode = "REGEX"
+	Field     Mode = "FIELD"
+)
+
+// Highlight is the [Start, End) byte range of a match in a payload.
+type Highlight struct {
+	Start int
+	End   int
+}
+
+// Matcher tests payloads against a query.
+type Matcher interface {
+	// Match returns true if payload matches, and the ranges of payload that matched when they are known.
+	Match(payload []byte) ([]*Highlight, bool)
+}
+
+// Compile returns a matcher for query.
+//
+// Field queries are made of predicates joined by "and", like "sensor.battery < 10 and sensor.kind == probe".
+// Supported operators are ==, !=, <, <=, > and >=. Values are compared as numbers when both sides are numeric,
+// and as strings otherwise.
+func Compile(mode Mode, query string) (Matcher, error) {
+	if query == "" {
+		return nil, errors.New("query must not be empty")
+	}
+	switch mode {
+	case Substring:
+		return substringMatcher([]byte(query)), nil
+	case Regex:
+		expr, err := regexp.Compile(query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression: %w", err)
+		}
+		return regexMatcher{expr: expr}, nil
+	case Field:
+		return compileFieldQuery(query)
+	default:
+		return nil, fmt.Errorf("unsupported search mode %q", mode)
+	}
+}
+
+type substringMatcher []byte
+
+func (m substringMatcher) Match(payload []byte) ([]*Highlight, bool) {
+	var out []*Highlight
+	offset := 0
+	for {
+		idx := bytes.Index(payload[offset:], m)
+		if idx < 0 {
+			break
+		}
+		start := offset + idx
+		out = append(out, &Highlight{Start: start, End: start + len(m)})
+		offset = start + len(m)
+	}
+	return out, len(out) > 0
+}
+
+type regexMatcher struct {
+	expr *regexp.Regexp
+}
+
+func (m regexMatcher) Match(payload []byte) ([]*Highlight, bool) {
+	indexes := m.expr.FindAllIndex(payload, -1)
+	out := make([]*Highlight, len(indexes))
+	for idx, match := range indexes {
+		out[idx] = &Highlight{Start: match[0], End: match[1]}
+	}
+	return out, len(out) > 0
+}
+
+type predicate struct {
+	path     jsonpath.Path
+	operator string
+	value    string
+}
+
+type fieldMatcher []predicate
+
+var (
+	operators       = []string{"==", "!=", "<=", ">=", "<", ">"}
+	clauseSeparator = regexp.MustCompile(`(?i)\s+and\s+`)
+)
+
+func compileFieldQuery(query string) (fieldMatcher, error) {
+	out := fieldMatcher{}
+	for _, clause := range splitClauses(query) {
+		idx, operator := findOperator(clause)
+		if operator == "" {
+			return nil, fmt.Errorf("invalid predicate %q: expected <path> <operator> <value>", clause)
+		}
+		path, err := jsonpath.Parse(strings.TrimSpace(clause[:idx]))
+		if err != nil {
+			return nil, err
+		}
+		value := strings.TrimSpace(clause[idx+len(operator):])
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		out = append(out, predicate{path: path, operator: operator, value: value})
+	}
+	return out, nil
+}
+
+// findOperator returns the position and the operator found right after the path of clause, so that
+// operator characters in the compared value, or in quoted path segments, are never mistaken for the operator.
+func findOperator(clause string) (int, string) {
+	quote := rune(0)
+	for idx, c := range clause {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case strings.ContainsRune("=!<>", c):
+			for _, operator := range operators {
+				if strings.HasPrefix(clause[idx:], operator) {
+					return idx, operator
+				}
+			}
+			return idx, ""
+		}
+	}
+	return -1, ""
+}
+
+func splitClauses(query string) []string {
+	out := []string{}
+	for _, clause := range clauseSeparator.Split(query, -1) {
+		if clause = strings.TrimSpace(clause); clause != "" {
+			out = append(out, clause)
+		}
+	}
+	return out
+}
+
+func (m fieldMatcher) Match(payload []byte) ([]*Highlight, bool) {
+	decoded, _, err := decoding.Detect(payload, nil)
+	if err != nil {
+		return nil, false
+	}
+	for _, p := range m {
+		value, ok := p.path.Lookup(decoded)
+		if !ok || !p.eval(value) {
+			return nil, false
+		}
+	}
+	return nil, true
+}
+
+func (p predicate) eval(value interface{}) bool {
+	if number, ok := jsonpath.ToNumber(value); ok {
+		if expected, err := strconv.ParseFloat(p.value, 64); err == nil {
+			return compare(p.operator, floatCompare(number, expected))
+		}
+	}
+	return compare(p.operator, strings.Compare(fmt.Sprint(value), p.value))
+}
+
+func floatCompare(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compare(operator string, result int) bool {
+	switch operator {
+	case "==":
+		return result == 0
+	case "!=":
+		return result != 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	default:
+		return false
+	}
+}
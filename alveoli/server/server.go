@@ -0,0 +1,122 @@
+// Package server assembles the alveoli HTTP API: REST handlers, GraphQL API and GraphQL playground.
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/gorilla/websocket"
+	"github.com/julienschmidt/httprouter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/certificates"
+	"github.com/vx-labs/alveoli/alveoli/configuration"
+	"github.com/vx-labs/alveoli/alveoli/decoding"
+	"github.com/vx-labs/alveoli/alveoli/deletion"
+	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+	"github.com/vx-labs/alveoli/alveoli/graph/resolvers"
+	"github.com/vx-labs/alveoli/alveoli/handlers"
+	"github.com/vx-labs/alveoli/alveoli/provisioning"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	"github.com/vx-labs/alveoli/alveoli/topics"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+)
+
+// errorCode returns the GraphQL error code matching err, or an empty string.
+func errorCode(err error) string {
+	var topicError *topics.Error
+	var configurationError *configuration.Error
+	var certificateError *certificates.Error
+	var provisioningError *provisioning.Error
+	var inputError *resolvers.InputError
+	var tenancyError *tenancy.Error
+	var decodingError *decoding.Error
+	var inUseError *resolvers.ApplicationInUseError
+	switch {
+	case errors.As(err, &topicError), errors.As(err, &configurationError), errors.As(err, &certificateError),
+		errors.As(err, &provisioningError), errors.As(err, &inputError), errors.As(err, &tenancyError),
+		errors.As(err, &decodingError),
+		errors.Is(err, certificates.ErrNotFound), errors.Is(err, tenancy.ErrForeignTenant),
+		errors.Is(err, deletion.ErrInvalidConfirmation), errors.Is(err, deletion.ErrNotRequested):
+		return "BAD_USER_INPUT"
+	case errors.As(err, &inUseError), errors.Is(err, certificates.ErrDisabled), errors.Is(err, deletion.ErrInProgress):
+		return "FAILED_PRECONDITION"
+	}
+	return ""
+}
+
+// GraphQL returns the GraphQL server exposing root, authenticating websocket sessions with authProvider.
+func GraphQL(root generated.ResolverRoot, authProvider auth.Provider) *handler.Server {
+	srv := handler.New(
+		generated.NewExecutableSchema(
+			generated.Config{
+				Resolvers: root,
+			},
+		),
+	)
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
+			md, err := authProvider.Validate(ctx, initPayload.Authorization())
+			if err != nil {
+				log.Printf("websocket auth failed: %v", err)
+				return nil, err
+			}
+			log.Printf("websocket session started for account %s", md.AccountID)
+			return auth.StoreInformations(ctx, md), nil
+		},
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New(1000))
+	srv.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
+		out := graphql.DefaultErrorPresenter(ctx, err)
+		if code := errorCode(err); code != "" {
+			if out.Extensions == nil {
+				out.Extensions = map[string]interface{}{}
+			}
+			out.Extensions["code"] = code
+		}
+		return out
+	})
+
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
+	})
+	return srv
+}
+
+// Handler returns the HTTP handler serving the REST API, the GraphQL API on /graphql, and the GraphQL playground.
+// authority must be the one used by root, so that both APIs share its revocation list cache.
+func Handler(authProvider auth.Provider, vespiaryClient vespiary.VespiaryClient, nestClient nest.MessagesClient, root generated.ResolverRoot, authority *certificates.Authority) http.Handler {
+	mux := http.NewServeMux()
+	router := httprouter.New()
+	handlers.Register(router, authProvider, vespiaryClient, nestClient, root, authority)
+	for _, prefix := range []string{"/account/", "/applications", "/applications/", "/application-profiles", "/application-profiles/", "/topics", "/sessions", "/sessions/",
+		"/device-certificates", "/device-certificates/", "/device-authorities/", "/openapi.json"} {
+		mux.Handle(prefix, router)
+	}
+	mux.Handle("/graphql", auth.Handler(authProvider, GraphQL(root, authProvider)))
+	mux.Handle("/", playground.Handler("GraphQL playground", "/graphql"))
+	return mux
+}
@@ -0,0 +1,120 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Aggregate is the function used to downsample the values of a bucket.
+type Aggregate string
+
+const (
+	Avg  Aggregate = "AVG"
+	Min  Aggregate = "MIN"
+	Max  Aggregate = "MAX"
+	Sum  Aggregate = "SUM"
+	Last Aggregate = "LAST"
+)
+
+// Point is the downsampled value of a time series during [Start, End).
+// Value is nil when no value was found during the bucket.
+type Point struct {
+	Start time.Time
+	End   time.Time
+	Value *float64
+	Count int
+}
+
+type seriesState struct {
+	sum      float64
+	min      float64
+	max      float64
+	last     float64
+	lastSeen int64
+}
+
+// SeriesAggregator downsamples a stream of numeric values.
+type SeriesAggregator struct {
+	aggregate Aggregate
+	from      time.Time
+	to        time.Time
+	width     time.Duration
+	points    []*Point
+	states    []seriesState
+}
+
+// NewSeriesAggregator returns an aggregator splitting [from, to) in buckets of the given width, and
+// downsampling values using aggregate.
+func NewSeriesAggregator(from, to time.Time, width time.Duration, aggregate Aggregate) (*SeriesAggregator, error) {
+	switch aggregate {
+	case Avg, Min, Max, Sum, Last:
+	default:
+		return nil, fmt.Errorf("unsupported aggregate %q", aggregate)
+	}
+	buckets, err := NewAggregator(from, to, width)
+	if err != nil {
+		return nil, err
+	}
+	a := &SeriesAggregator{
+		aggregate: aggregate,
+		from:      from,
+		to:        to,
+		width:     width,
+		points:    make([]*Point, len(buckets.buckets)),
+		states:    make([]seriesState, len(buckets.buckets)),
+	}
+	for idx, bucket := range buckets.buckets {
+		a.points[idx] = &Point{Start: bucket.Start, End: bucket.End}
+	}
+	return a, nil
+}
+
+// Add accounts value, observed at timestamp in nanoseconds. Values outside of the aggregation range are ignored.
+func (a *SeriesAggregator) Add(timestamp int64, value float64) {
+	t := time.Unix(0, timestamp)
+	if t.Before(a.from) || !t.Before(a.to) {
+		return
+	}
+	idx := int(t.Sub(a.from) / a.width)
+	point := a.points[idx]
+	state := &a.states[idx]
+	if point.Count == 0 {
+		state.min = math.Inf(1)
+		state.max = math.Inf(-1)
+	}
+	point.Count++
+	state.sum += value
+	state.min = math.Min(state.min, value)
+	state.max = math.Max(state.max, value)
+	if point.Count == 1 || timestamp >= state.lastSeen {
+		state.last = value
+		state.lastSeen = timestamp
+	}
+}
+
+// Points returns the downsampled series, in chronological order.
+func (a *SeriesAggregator) Points() []*Point {
+	for idx, point := range a.points {
+		if point.Count == 0 {
+			point.Value = nil
+			continue
+		}
+		state := a.states[idx]
+		var value float64
+		switch a.aggregate {
+		case Avg:
+			value = state.sum / float64(point.Count)
+		case Min:
+			value = state.min
+		case Max:
+			value = state.max
+		case Sum:
+			value = state.sum
+		case Last:
+			value = state.last
+		}
+		point.Value = &value
+	}
+	return a.points
+}
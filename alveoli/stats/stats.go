@@ -0,0 +1,80 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MaxBuckets is the maximum number of buckets a single aggregation can produce.
+const MaxBuckets = 1000
+
+// Bucket holds the statistics of the messages published during [Start, End).
+type Bucket struct {
+	Start           time.Time
+	End             time.Time
+	MessageCount    int
+	SizeInBytes     int
+	DistinctSenders int
+}
+
+// Aggregator computes per-bucket statistics of a stream of messages.
+type Aggregator struct {
+	from    time.Time
+	to      time.Time
+	width   time.Duration
+	buckets []*Bucket
+	senders []map[string]struct{}
+}
+
+// NewAggregator returns an aggregator splitting [from, to) in buckets of the given width.
+func NewAggregator(from, to time.Time, width time.Duration) (*Aggregator, error) {
+	if width <= 0 {
+		return nil, errors.New("bucket width must be positive")
+	}
+	if !to.After(from) {
+		return nil, errors.New("to must be after from")
+	}
+	count := int((to.Sub(from) + width - 1) / width)
+	if count > MaxBuckets {
+		return nil, fmt.Errorf("too many buckets requested: use a larger bucket or a shorter time range to stay under %d buckets", MaxBuckets)
+	}
+	a := &Aggregator{
+		from:    from,
+		to:      to,
+		width:   width,
+		buckets: make([]*Bucket, count),
+		senders: make([]map[string]struct{}, count),
+	}
+	for idx := range a.buckets {
+		start := from.Add(time.Duration(idx) * width)
+		end := start.Add(width)
+		if end.After(to) {
+			end = to
+		}
+		a.buckets[idx] = &Bucket{Start: start, End: end}
+		a.senders[idx] = map[string]struct{}{}
+	}
+	return a, nil
+}
+
+// Add accounts a message published at timestamp, in nanoseconds. Messages outside of the aggregation range are ignored.
+func (a *Aggregator) Add(timestamp int64, sender string, size int) {
+	t := time.Unix(0, timestamp)
+	if t.Before(a.from) || !t.Before(a.to) {
+		return
+	}
+	idx := int(t.Sub(a.from) / a.width)
+	bucket := a.buckets[idx]
+	bucket.MessageCount++
+	bucket.SizeInBytes += size
+	if _, ok := a.senders[idx][sender]; !ok {
+		a.senders[idx][sender] = struct{}{}
+		bucket.DistinctSenders++
+	}
+}
+
+// Buckets returns the computed statistics, in chronological order.
+func (a *Aggregator) Buckets() []*Bucket {
+	return a.buckets
+}
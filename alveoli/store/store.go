@@ -0,0 +1,260 @@
+package store
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	nest "github.com/vx-labs/nest/nest/api"
+)
+
+// ErrNotFound is returned when the requested key does not exist.
+var ErrNotFound = errors.New("key not found")
+
+const (
+	rootPrefix = "_alveoli"
+	sender     = "alveoli"
+)
+
+// snapshotTTL is how long a store reuses the values it read from nest. Values written by other alveoli instances
+// may be seen with this delay.
+const snapshotTTL = 5 * time.Second
+
+// Store persists alveoli configuration as nest records.
+//
+// Each value is stored as the last record of a dedicated topic under the "_alveoli/<account id>" prefix,
+// which is outside of the "_root" tree used by devices and tenant queries.
+// Deleting a key writes an empty record.
+//
+// Nest is a message log, not a database, and the store inherits its limits:
+//   - nest cannot delete records, so deleted and overwritten values stay in the topic history forever. Secrets
+//     must never be stored in clear text.
+//   - there is no conditional write: concurrent writers of a key race, and the last write wins.
+//   - reads go through nest topic listings. The store keeps a compacted copy of the latest value of each key,
+//     per account and kind, for snapshotTTL.
+type Store struct {
+	nest      nest.MessagesClient
+	mtx       sync.Mutex
+	snapshots map[string]snapshot
+}
+
+type snapshot struct {
+	values  map[string][]byte
+	expires time.Time
+}
+
+// New returns a store backed by the provided nest client.
+func New(nestClient nest.MessagesClient) *Store {
+	return &Store{nest: nestClient, snapshots: map[string]snapshot{}}
+}
+
+func snapshotKey(accountID, kind string) string {
+	return accountID + "/" + kind
+}
+
+// load returns the latest values of an account and kind namespace, from the snapshot when it is still fresh.
+// The returned map must not be modified.
+func (s *Store) load(ctx context.Context, accountID, kind string) (map[string][]byte, error) {
+	key := snapshotKey(accountID, kind)
+	s.mtx.Lock()
+	cached, ok := s.snapshots[key]
+	s.mtx.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.values, nil
+	}
+	values, err := s.fetch(ctx, accountID, kind)
+	if err != nil {
+		return nil, err
+	}
+	s.mtx.Lock()
+	s.snapshots[key] = snapshot{values: values, expires: time.Now().Add(snapshotTTL)}
+	s.mtx.Unlock()
+	return values, nil
+}
+
+// update applies a write made by this store to the snapshot of its namespace, if any.
+func (s *Store) update(accountID, kind, key string, value []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	cached, ok := s.snapshots[snapshotKey(accountID, kind)]
+	if !ok {
+		return
+	}
+	values := make(map[string][]byte, len(cached.values)+1)
+	for k, v := range cached.values {
+		values[k] = v
+	}
+	if len(value) == 0 {
+		delete(values, key)
+	} else {
+		values[key] = value
+	}
+	s.snapshots[snapshotKey(accountID, kind)] = snapshot{values: values, expires: cached.expires}
+}
+
+func topic(accountID, kind, key string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", rootPrefix, accountID, kind, hex.EncodeToString([]byte(key))))
+}
+
+func keyFromTopic(name []byte) (string, error) {
+	idx := strings.LastIndexByte(string(name), '/')
+	if idx < 0 {
+		return "", errors.New("invalid store topic")
+	}
+	key, err := hex.DecodeString(string(name[idx+1:]))
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
+}
+
+// Put stores value under key, in the given account and kind namespace.
+func (s *Store) Put(ctx context.Context, accountID, kind, key string, value []byte) error {
+	if len(value) == 0 {
+		return errors.New("empty values cannot be stored")
+	}
+	return s.put(ctx, accountID, kind, key, value)
+}
+
+func (s *Store) put(ctx context.Context, accountID, kind, key string, value []byte) error {
+	_, err := s.nest.PutRecords(ctx, &nest.PutRecordsRequest{
+		Records: []*nest.Record{
+			{
+				Timestamp: time.Now().UnixNano(),
+				Topic:     topic(accountID, kind, key),
+				Payload:   value,
+				Sender:    sender,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	s.update(accountID, kind, key, value)
+	return nil
+}
+
+// Get returns the value stored under key.
+func (s *Store) Get(ctx context.Context, accountID, kind, key string) ([]byte, error) {
+	values, err := s.load(ctx, accountID, kind)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := values[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return value, nil
+}
+
+// Delete removes the value stored under key.
+func (s *Store) Delete(ctx context.Context, accountID, kind, key string) error {
+	return s.put(ctx, accountID, kind, key, nil)
+}
+
+// List returns all values stored in the given account and kind namespace, indexed by key.
+func (s *Store) List(ctx context.Context, accountID, kind string) (map[string][]byte, error) {
+	values, err := s.load(ctx, accountID, kind)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string][]byte, len(values))
+	for key, value := range values {
+		out[key] = value
+	}
+	return out, nil
+}
+
+func (s *Store) fetch(ctx context.Context, accountID, kind string) (map[string][]byte, error) {
+	out, err := s.nest.ListTopics(ctx, &nest.ListTopicsRequest{
+		Pattern: []byte(fmt.Sprintf("%s/%s/%s/+", rootPrefix, accountID, kind)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string][]byte, len(out.TopicMetadatas))
+	for _, metadata := range out.TopicMetadatas {
+		if metadata.LastRecord == nil || len(metadata.LastRecord.Payload) == 0 {
+			continue
+		}
+		key, err := keyFromTopic(metadata.Name)
+		if err != nil {
+			continue
+		}
+		values[key] = metadata.LastRecord.Payload
+	}
+	return values, nil
+}
+
+// ListAccounts returns all values stored in the given kind namespace, indexed by account ID and key.
+// It is meant to be used by background tasks working on behalf of all accounts.
+func (s *Store) ListAccounts(ctx context.Context, kind string) (map[string]map[string][]byte, error) {
+	out, err := s.nest.ListTopics(ctx, &nest.ListTopicsRequest{
+		Pattern: []byte(fmt.Sprintf("%s/+/%s/+", rootPrefix, kind)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]map[string][]byte{}
+	for _, metadata := range out.TopicMetadatas {
+		if metadata.LastRecord == nil || len(metadata.LastRecord.Payload) == 0 {
+			continue
+		}
+		tokens := strings.Split(string(metadata.Name), "/")
+		if len(tokens) != 4 {
+			continue
+		}
+		key, err := keyFromTopic(metadata.Name)
+		if err != nil {
+			continue
+		}
+		accountID := tokens[1]
+		if values[accountID] == nil {
+			values[accountID] = map[string][]byte{}
+		}
+		values[accountID][key] = metadata.LastRecord.Payload
+	}
+	return values, nil
+}
+
+// DeleteAll removes all values stored for an account, except the ones of the kinds listed in keep.
+// It returns the number of removed values.
+func (s *Store) DeleteAll(ctx context.Context, accountID string, keep ...string) (int, error) {
+	out, err := s.nest.ListTopics(ctx, &nest.ListTopicsRequest{
+		Pattern: []byte(fmt.Sprintf("%s/%s/+/+", rootPrefix, accountID)),
+	})
+	if err != nil {
+		return 0, err
+	}
+	kept := make(map[string]struct{}, len(keep))
+	for _, kind := range keep {
+		kept[kind] = struct{}{}
+	}
+	count := 0
+	for _, metadata := range out.TopicMetadatas {
+		if metadata.LastRecord == nil || len(metadata.LastRecord.Payload) == 0 {
+			continue
+		}
+		tokens := strings.Split(string(metadata.Name), "/")
+		if len(tokens) != 4 {
+			continue
+		}
+		if _, ok := kept[tokens[2]]; ok {
+			continue
+		}
+		key, err := keyFromTopic(metadata.Name)
+		if err != nil {
+			continue
+		}
+		err = s.Delete(ctx, accountID, tokens[2], key)
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
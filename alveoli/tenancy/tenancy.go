@@ -0,0 +1,118 @@
+// Package tenancy maps accounts and applications to the names used by the broker and the record store.
+//
+// Devices of an application are mounted on "_root/<accountID>/<applicationID>": their topics are stored as
+// "_root/<accountID>/<applicationID>/<name>", and vespiary identifies their sessions by
+// "<applicationName>/<applicationProfileName>/<id>".
+package tenancy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Root is the first level of all tenant mount points.
+const Root = "_root"
+
+// AnyApplication is used as application ID to match the topics of all the applications of an account.
+const AnyApplication = "+"
+
+// Error is returned when a name does not follow the tenancy layout.
+type Error struct {
+	Kind  string
+	Input string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid %s %q", e.Kind, e.Input)
+}
+
+// ErrForeignTenant is returned when a name belongs to another account.
+var ErrForeignTenant = errors.New("resource belongs to another account")
+
+// AccountMountPoint returns the prefix shared by the mount points of all the applications of an account.
+func AccountMountPoint(accountID string) string {
+	return Root + "/" + accountID
+}
+
+// MountPoint returns the mount point of the devices of an application.
+func MountPoint(accountID, applicationID string) string {
+	return AccountMountPoint(accountID) + "/" + applicationID
+}
+
+// TopicPrefix returns the prefix of all the topics of an application, including the trailing slash.
+func TopicPrefix(accountID, applicationID string) string {
+	return MountPoint(accountID, applicationID) + "/"
+}
+
+// Topic returns the stored name of an application topic.
+func Topic(accountID, applicationID, name string) []byte {
+	return []byte(TopicPrefix(accountID, applicationID) + name)
+}
+
+// TopicPattern returns the stored pattern matching filter inside an application.
+// Use AnyApplication as applicationID to match filter in all the applications of the account.
+func TopicPattern(accountID, applicationID, filter string) []byte {
+	return []byte(TopicPrefix(accountID, applicationID) + filter)
+}
+
+// TopicName is a parsed stored topic name.
+type TopicName struct {
+	AccountID     string
+	ApplicationID string
+	Name          string
+}
+
+// ParseTopic splits a stored topic name.
+func ParseTopic(topic []byte) (TopicName, error) {
+	tokens := strings.SplitN(string(topic), "/", 4)
+	if len(tokens) != 4 || tokens[0] != Root || tokens[1] == "" || tokens[2] == "" || tokens[3] == "" {
+		return TopicName{}, &Error{Kind: "topic", Input: string(topic)}
+	}
+	return TopicName{AccountID: tokens[1], ApplicationID: tokens[2], Name: tokens[3]}, nil
+}
+
+// ParseOwnedTopic splits a stored topic name, and ensures it belongs to accountID.
+func ParseOwnedTopic(accountID string, topic []byte) (TopicName, error) {
+	out, err := ParseTopic(topic)
+	if err != nil {
+		return out, err
+	}
+	if out.AccountID != accountID {
+		return TopicName{}, ErrForeignTenant
+	}
+	return out, nil
+}
+
+// ParseMountPoint returns the account and the application of a session mount point.
+func ParseMountPoint(mountPoint string) (accountID string, applicationID string, err error) {
+	tokens := strings.Split(mountPoint, "/")
+	if len(tokens) != 3 || tokens[0] != Root || tokens[1] == "" || tokens[2] == "" {
+		return "", "", &Error{Kind: "mount point", Input: mountPoint}
+	}
+	return tokens[1], tokens[2], nil
+}
+
+// OwnsMountPoint returns true if the session mounted on mountPoint belongs to accountID.
+// When applicationID is not empty, the session must also belong to this application.
+func OwnsMountPoint(accountID, applicationID, mountPoint string) bool {
+	sessionAccountID, sessionApplicationID, err := ParseMountPoint(mountPoint)
+	if err != nil || sessionAccountID != accountID {
+		return false
+	}
+	return applicationID == "" || sessionApplicationID == applicationID
+}
+
+// SessionID returns the broker session ID vespiary assigns to a device connected with an application profile.
+func SessionID(applicationName, applicationProfileName, id string) string {
+	return applicationName + "/" + applicationProfileName + "/" + id
+}
+
+// ParseSessionID returns the application name, the application profile name and the device ID of a broker session ID.
+func ParseSessionID(sessionID string) (applicationName string, applicationProfileName string, id string, err error) {
+	tokens := strings.SplitN(sessionID, "/", 3)
+	if len(tokens) != 3 || tokens[0] == "" || tokens[1] == "" || tokens[2] == "" {
+		return "", "", "", &Error{Kind: "session id", Input: sessionID}
+	}
+	return tokens[0], tokens[1], tokens[2], nil
+}
@@ -0,0 +1,108 @@
+package tenancy
+
+import "testing"
+
+func TestParseTopic(t *testing.T) {
+	for _, tc := range []struct {
+		topic string
+		want  *TopicName
+	}{
+		{topic: "_root/1/2/sensors", want: &TopicName{AccountID: "1", ApplicationID: "2", Name: "sensors"}},
+		{topic: "_root/1/2/sensors/temperature", want: &TopicName{AccountID: "1", ApplicationID: "2", Name: "sensors/temperature"}},
+		{topic: "_root/1/2/"},
+		{topic: "_root/1/2"},
+		{topic: "_root/1//sensors"},
+		{topic: "_root//2/sensors"},
+		{topic: "other/1/2/sensors"},
+		{topic: "_alveoli/1/2/sensors"},
+		{topic: ""},
+	} {
+		out, err := ParseTopic([]byte(tc.topic))
+		if tc.want == nil {
+			if _, ok := err.(*Error); !ok {
+				t.Errorf("ParseTopic(%q): expected an *Error, got %+v, %v", tc.topic, out, err)
+			}
+			continue
+		}
+		if err != nil || out != *tc.want {
+			t.Errorf("ParseTopic(%q): got %+v, %v", tc.topic, out, err)
+		}
+	}
+}
+
+func TestParseOwnedTopic(t *testing.T) {
+	if _, err := ParseOwnedTopic("1", Topic("1", "2", "sensors")); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if _, err := ParseOwnedTopic("1", Topic("12", "2", "sensors")); err != ErrForeignTenant {
+		t.Errorf("expected ErrForeignTenant, got %v", err)
+	}
+	if _, err := ParseOwnedTopic("12", Topic("1", "2", "sensors")); err != ErrForeignTenant {
+		t.Errorf("expected ErrForeignTenant, got %v", err)
+	}
+}
+
+func TestParseMountPoint(t *testing.T) {
+	for _, tc := range []struct {
+		mountPoint    string
+		accountID     string
+		applicationID string
+		valid         bool
+	}{
+		{mountPoint: "_root/1/2", accountID: "1", applicationID: "2", valid: true},
+		{mountPoint: MountPoint("account", "application"), accountID: "account", applicationID: "application", valid: true},
+		{mountPoint: "_root/1"},
+		{mountPoint: "_root/1/"},
+		{mountPoint: "_root//2"},
+		{mountPoint: "_root/1/2/3"},
+		{mountPoint: "other/1/2"},
+		{mountPoint: ""},
+	} {
+		accountID, applicationID, err := ParseMountPoint(tc.mountPoint)
+		if !tc.valid {
+			if _, ok := err.(*Error); !ok {
+				t.Errorf("ParseMountPoint(%q): expected an *Error, got %v", tc.mountPoint, err)
+			}
+			continue
+		}
+		if err != nil || accountID != tc.accountID || applicationID != tc.applicationID {
+			t.Errorf("ParseMountPoint(%q): got %q, %q, %v", tc.mountPoint, accountID, applicationID, err)
+		}
+	}
+}
+
+func TestOwnsMountPoint(t *testing.T) {
+	for _, tc := range []struct {
+		accountID     string
+		applicationID string
+		mountPoint    string
+		owned         bool
+	}{
+		{accountID: "1", mountPoint: "_root/1/2", owned: true},
+		{accountID: "1", applicationID: "2", mountPoint: "_root/1/2", owned: true},
+		{accountID: "1", applicationID: "3", mountPoint: "_root/1/2"},
+		{accountID: "1", mountPoint: "_root/12/2"},
+		{accountID: "12", mountPoint: "_root/1/2"},
+		{accountID: "1", applicationID: "2", mountPoint: "_root/1/23"},
+		{accountID: "1", mountPoint: "_root/1"},
+		{accountID: "1", mountPoint: "_root/1/2/3"},
+		{accountID: "1", mountPoint: "1/2"},
+		{accountID: "", mountPoint: "_root//2"},
+	} {
+		if OwnsMountPoint(tc.accountID, tc.applicationID, tc.mountPoint) != tc.owned {
+			t.Errorf("OwnsMountPoint(%q, %q, %q) should be %v", tc.accountID, tc.applicationID, tc.mountPoint, tc.owned)
+		}
+	}
+}
+
+func TestParseSessionID(t *testing.T) {
+	applicationName, profileName, id, err := ParseSessionID(SessionID("greenhouse", "sensors", "5b0e"))
+	if err != nil || applicationName != "greenhouse" || profileName != "sensors" || id != "5b0e" {
+		t.Errorf("unexpected session ID parts %q, %q, %q, %v", applicationName, profileName, id, err)
+	}
+	for _, sessionID := range []string{"", "greenhouse", "greenhouse/sensors", "greenhouse/sensors/", "/sensors/5b0e", "greenhouse//5b0e"} {
+		if _, _, _, err := ParseSessionID(sessionID); err == nil {
+			t.Errorf("ParseSessionID(%q): expected an error", sessionID)
+		}
+	}
+}
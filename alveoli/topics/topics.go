@@ -0,0 +1,105 @@
+package topics
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxLength is the maximum length of MQTT topics, in bytes.
+const maxLength = 65535
+
+// Match returns true if the MQTT topic filter pattern matches topic.
+func Match(pattern, topic string) bool {
+	patternTokens := strings.Split(pattern, "/")
+	topicTokens := strings.Split(topic, "/")
+	for idx, token := range patternTokens {
+		if token == "#" {
+			return true
+		}
+		if idx >= len(topicTokens) {
+			return false
+		}
+		if token != "+" && token != topicTokens[idx] {
+			return false
+		}
+	}
+	return len(patternTokens) == len(topicTokens)
+}
+
+// Error describes an invalid topic name or topic filter provided by a user.
+type Error struct {
+	Input  string
+	Filter bool
+	Reason string
+}
+
+func (e *Error) Error() string {
+	kind := "topic name"
+	if e.Filter {
+		kind = "topic filter"
+	}
+	return fmt.Sprintf("invalid %s %q: %s", kind, e.Input, e.Reason)
+}
+
+// ValidateFilter checks that filter is a valid MQTT topic filter, relative to an application.
+//
+// Besides the MQTT 3.1.1 and 5 wildcard rules, empty levels (including leading and trailing slashes) are rejected,
+// as are "." and ".." levels, so that user filters always stay inside their tenant prefix. Topics starting with '$'
+// are reserved for the broker.
+func ValidateFilter(filter string) error {
+	return validate(filter, true)
+}
+
+// ValidateName checks that name is a valid MQTT topic name, relative to an application.
+// It follows the ValidateFilter rules, and must not contain wildcards.
+func ValidateName(name string) error {
+	return validate(name, false)
+}
+
+func validate(input string, filter bool) error {
+	fail := func(reason string, args ...interface{}) error {
+		return &Error{Input: input, Filter: filter, Reason: fmt.Sprintf(reason, args...)}
+	}
+	if input == "" {
+		return fail("must not be empty")
+	}
+	if len(input) > maxLength {
+		return fail("must not be longer than %d bytes", maxLength)
+	}
+	if !utf8.ValidString(input) {
+		return fail("must be valid UTF-8")
+	}
+	if strings.ContainsRune(input, 0) {
+		return fail("must not contain null characters")
+	}
+	if strings.HasPrefix(input, "$") {
+		return fail("topics starting with '$' are reserved for the broker")
+	}
+	levels := strings.Split(input, "/")
+	for idx, level := range levels {
+		switch {
+		case level == "":
+			if idx == 0 {
+				return fail("must not start with '/'")
+			}
+			if idx == len(levels)-1 {
+				return fail("must not end with '/'")
+			}
+			return fail("level %d is empty", idx+1)
+		case level == "." || level == "..":
+			return fail("level %d must not be %q", idx+1, level)
+		case !filter && strings.ContainsAny(level, "+#"):
+			return fail("wildcards are only allowed in topic filters")
+		case level == "#":
+			if idx != len(levels)-1 {
+				return fail("'#' must be the last level")
+			}
+		case strings.Contains(level, "#"):
+			return fail("'#' must occupy a whole level")
+		case level != "+" && strings.Contains(level, "+"):
+			return fail("'+' must occupy a whole level")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package topics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		input  string
+		name   bool
+		filter bool
+	}{
+		{input: "sensors", name: true, filter: true},
+		{input: "sensors/temperature", name: true, filter: true},
+		{input: "sensors/room 1/temperature", name: true, filter: true},
+		{input: "sensors/température", name: true, filter: true},
+		{input: "sensors/a$b", name: true, filter: true},
+		{input: "#", filter: true},
+		{input: "+", filter: true},
+		{input: "sensors/#", filter: true},
+		{input: "sensors/+/temperature", filter: true},
+		{input: "+/+/#", filter: true},
+		{input: ""},
+		{input: "/"},
+		{input: "/sensors"},
+		{input: "sensors/"},
+		{input: "sensors//temperature"},
+		{input: "sensors/#/temperature"},
+		{input: "#/sensors"},
+		{input: "sensors#"},
+		{input: "sensors/temp#"},
+		{input: "sensors/+temperature"},
+		{input: "sensors/temp+"},
+		{input: "++"},
+		{input: "."},
+		{input: ".."},
+		{input: "sensors/../other"},
+		{input: "sensors/./temperature"},
+		{input: "sensors/.hidden", name: true, filter: true},
+		{input: "$SYS"},
+		{input: "$SYS/broker"},
+		{input: "$share/group/sensors"},
+		{input: "sensors/\x00"},
+		{input: "sensors/\xff"},
+		{input: strings.Repeat("a", maxLength+1)},
+	} {
+		if err := ValidateName(tc.input); (err == nil) != tc.name {
+			t.Errorf("ValidateName(%q): unexpected error %v", tc.input, err)
+		}
+		if err := ValidateFilter(tc.input); (err == nil) != tc.filter {
+			t.Errorf("ValidateFilter(%q): unexpected error %v", tc.input, err)
+		}
+	}
+}
+
+func TestValidateError(t *testing.T) {
+	err := ValidateFilter("sensors/#/temperature")
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected an *Error, got %v", err)
+	}
+	if !e.Filter || e.Input != "sensors/#/temperature" || e.Reason != "'#' must be the last level" {
+		t.Fatalf("unexpected error %+v", e)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	for _, tc := range []struct {
+		pattern string
+		topic   string
+		match   bool
+	}{
+		{pattern: "sensors/temperature", topic: "sensors/temperature", match: true},
+		{pattern: "sensors/temperature", topic: "sensors/humidity"},
+		{pattern: "sensors/+", topic: "sensors/temperature", match: true},
+		{pattern: "sensors/+", topic: "sensors/room/temperature"},
+		{pattern: "sensors/#", topic: "sensors/room/temperature", match: true},
+		{pattern: "sensors/#", topic: "sensors", match: true},
+		{pattern: "sensors/+/temperature", topic: "sensors/room/temperature", match: true},
+		{pattern: "sensors/+/temperature", topic: "sensors/room/humidity"},
+		{pattern: "sensors", topic: "sensors/temperature"},
+	} {
+		if Match(tc.pattern, tc.topic) != tc.match {
+			t.Errorf("Match(%q, %q) should be %v", tc.pattern, tc.topic, tc.match)
+		}
+	}
+}
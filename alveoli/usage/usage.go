@@ -0,0 +1,102 @@
+package usage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+)
+
+// ErrQuotaExceeded is returned when creating a resource would exceed the account quotas.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// Scope identifies the resources whose usage is reported: a whole account, or one of its applications
+// when ApplicationID is not empty.
+type Scope struct {
+	AccountID     string
+	ApplicationID string
+}
+
+// MountPoint returns the broker mount point of the scope.
+func (s Scope) MountPoint() string {
+	if s.ApplicationID == "" {
+		return tenancy.AccountMountPoint(s.AccountID)
+	}
+	return tenancy.MountPoint(s.AccountID, s.ApplicationID)
+}
+
+// TopicPattern returns the nest pattern matching all the topics of the scope.
+func (s Scope) TopicPattern() []byte {
+	return []byte(s.MountPoint() + "/#")
+}
+
+// Contains returns true if the session mounted on mountPoint belongs to the scope.
+func (s Scope) Contains(mountPoint string) bool {
+	return tenancy.OwnsMountPoint(s.AccountID, s.ApplicationID, mountPoint)
+}
+
+// Quotas limits the resources an account can create. A zero value disables the matching limit.
+type Quotas struct {
+	MaxApplications        int `json:"maxApplications,omitempty"`
+	MaxApplicationProfiles int `json:"maxApplicationProfiles,omitempty"`
+	MaxStoredBytes         int `json:"maxStoredBytes,omitempty"`
+}
+
+// Limits holds the quotas applied to all accounts, and the overrides set by administrators for some of them.
+type Limits struct {
+	Default  Quotas
+	Accounts map[string]Quotas
+}
+
+// For returns the quotas applied to accountID. Overrides replace the default quotas as a whole, so an
+// empty override lifts all the limits of an account.
+func (l Limits) For(accountID string) Quotas {
+	if quotas, ok := l.Accounts[accountID]; ok {
+		return quotas
+	}
+	return l.Default
+}
+
+// LoadLimits returns limits using defaults, and the per-account overrides read from the JSON file at path,
+// mapping account IDs to their quotas. No override is loaded when path is empty.
+func LoadLimits(defaults Quotas, path string) (Limits, error) {
+	out := Limits{Default: defaults, Accounts: map[string]Quotas{}}
+	if path == "" {
+		return out, nil
+	}
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return out, err
+	}
+	err = json.Unmarshal(buf, &out.Accounts)
+	if err != nil {
+		return out, fmt.Errorf("invalid quota overrides file %s: %w", path, err)
+	}
+	return out, nil
+}
+
+// CheckApplications returns ErrQuotaExceeded if an account owning count applications cannot create a new one.
+func (q Quotas) CheckApplications(count int) error {
+	if q.MaxApplications > 0 && count >= q.MaxApplications {
+		return fmt.Errorf("%w: accounts cannot have more than %d applications", ErrQuotaExceeded, q.MaxApplications)
+	}
+	return nil
+}
+
+// CheckApplicationProfiles returns ErrQuotaExceeded if an application owning count profiles cannot create a new one.
+func (q Quotas) CheckApplicationProfiles(count int) error {
+	if q.MaxApplicationProfiles > 0 && count >= q.MaxApplicationProfiles {
+		return fmt.Errorf("%w: applications cannot have more than %d profiles", ErrQuotaExceeded, q.MaxApplicationProfiles)
+	}
+	return nil
+}
+
+// CheckStoredBytes returns ErrQuotaExceeded if an account storing size bytes reached its storage quota.
+func (q Quotas) CheckStoredBytes(size int) error {
+	if q.MaxStoredBytes > 0 && size >= q.MaxStoredBytes {
+		return fmt.Errorf("%w: accounts cannot store more than %d bytes", ErrQuotaExceeded, q.MaxStoredBytes)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/vx-labs/alveoli/alveoli/client"
+)
+
+const applyConfigurationMutation = `mutation($input: ApplyConfigurationInput!) {
+  applyConfiguration(input: $input) {
+    changes { kind action application name id reason }
+    dryRun
+  }
+}`
+
+type configurationChange struct {
+	Kind        string  `json:"kind"`
+	Action      string  `json:"action"`
+	Application string  `json:"application"`
+	Name        string  `json:"name"`
+	ID          *string `json:"id"`
+	Reason      *string `json:"reason"`
+}
+
+func applyConfiguration(ctx context.Context, c *client.Client, document string, dryRun, prune bool) ([]configurationChange, error) {
+	out := struct {
+		ApplyConfiguration struct {
+			Changes []configurationChange `json:"changes"`
+		} `json:"applyConfiguration"`
+	}{}
+	err := c.GraphQL(ctx, applyConfigurationMutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"document": document,
+			"dryRun":   dryRun,
+			"prune":    prune,
+		},
+	}, &out)
+	return out.ApplyConfiguration.Changes, err
+}
+
+// destructiveChanges returns the number of changes deleting resources.
+func destructiveChanges(changes []configurationChange) int {
+	count := 0
+	for _, change := range changes {
+		if change.Action == "DELETE" {
+			count++
+		}
+	}
+	return count
+}
+
+// printConfigurationChanges prints the changes which are not UNCHANGED, and returns their count.
+func printConfigurationChanges(changes []configurationChange) int {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+	count := 0
+	for _, change := range changes {
+		if change.Action == "UNCHANGED" {
+			continue
+		}
+		if count == 0 {
+			fmt.Fprintln(w, "ACTION\tKIND\tAPPLICATION\tNAME\tID\tREASON")
+		}
+		count++
+		id, reason := "-", ""
+		if change.ID != nil {
+			id = *change.ID
+		}
+		if change.Reason != nil {
+			reason = *change.Reason
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", change.Action, change.Kind, change.Application, change.Name, id, reason)
+	}
+	return count
+}
+
+// Apply makes the applications and profiles of an account match a configuration document.
+func Apply(config *viper.Viper) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "apply",
+		Short: "Create and delete applications and profiles to match a YAML or JSON configuration file.",
+		PreRun: func(c *cobra.Command, _ []string) {
+			config.BindPFlags(c.Flags())
+		},
+		Run: func(cmd *cobra.Command, _ []string) {
+			ctx := context.Background()
+			var document []byte
+			var err error
+			if filename := config.GetString("filename"); filename == "-" {
+				document, err = ioutil.ReadAll(os.Stdin)
+			} else {
+				document, err = ioutil.ReadFile(filename)
+			}
+			if err != nil {
+				log.Fatalf("failed to read configuration: %v", err)
+			}
+			api := apiClient(config)
+			prune := config.GetBool("prune")
+			plan, err := applyConfiguration(ctx, api, string(document), true, prune)
+			if err != nil {
+				log.Fatalf("failed to plan configuration changes: %v", err)
+			}
+			pending := printConfigurationChanges(plan)
+			if pending == 0 {
+				fmt.Printf("%d resources are up to date.\n", len(plan))
+				return
+			}
+			if config.GetBool("dry-run") {
+				fmt.Printf("\n%d changes planned, %d resources unchanged.\n", pending, len(plan)-pending)
+				return
+			}
+			if destructive := destructiveChanges(plan); destructive > 0 && !config.GetBool("yes") {
+				fmt.Printf("\n%d changes delete resources, revoking the device certificates of the affected profiles.\n", destructive)
+				log.Fatalf("refusing to apply destructive changes without --yes")
+			}
+			fmt.Println()
+			applied, err := applyConfiguration(ctx, api, string(document), false, prune)
+			if err != nil {
+				log.Fatalf("failed to apply configuration: %v", err)
+			}
+			count := 0
+			for _, change := range applied {
+				if change.Action != "UNCHANGED" {
+					count++
+				}
+			}
+			fmt.Printf("%d changes applied, %d resources unchanged.\n", count, len(applied)-count)
+		},
+	}
+	c.Flags().StringP("filename", "f", "", "Configuration file to apply, or - to read it from the standard input.")
+	c.MarkFlagRequired("filename")
+	c.Flags().Bool("dry-run", false, "Only print the planned changes.")
+	c.Flags().Bool("prune", false, "Delete applications and profiles missing from the configuration file. Pruned applications are deleted with their dependents.")
+	c.Flags().Bool("yes", false, "Confirm the changes deleting or replacing resources, which are not applied otherwise.")
+	addAPIClientFlags(c.Flags())
+	return c
+}
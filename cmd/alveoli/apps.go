@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"log"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type cliApplication struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Profiles []struct {
+		ID string `json:"id"`
+	} `json:"profiles,omitempty"`
+}
+
+// Apps manages the applications of an account.
+func Apps(config *viper.Viper) *cobra.Command {
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List applications.",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, _ []string) {
+			out := struct {
+				Applications []cliApplication `json:"applications"`
+			}{}
+			err := apiClient(config).GraphQL(context.Background(), `{ applications { id name profiles { id } } }`, nil, &out)
+			if err != nil {
+				log.Fatalf("failed to list applications: %v", err)
+			}
+			printOutput(config, out.Applications, func(w *tabwriter.Writer) {
+				printRow(w, "ID", "NAME", "PROFILES")
+				for _, application := range out.Applications {
+					printRow(w, application.ID, application.Name, len(application.Profiles))
+				}
+			})
+		},
+	}
+	create := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create an application.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			out := struct {
+				CreateApplication struct {
+					Application cliApplication `json:"application"`
+				} `json:"createApplication"`
+			}{}
+			err := apiClient(config).GraphQL(context.Background(),
+				`mutation($name: String!) { createApplication(input: {name: $name}) { application { id name } } }`,
+				map[string]interface{}{"name": args[0]}, &out)
+			if err != nil {
+				log.Fatalf("failed to create application: %v", err)
+			}
+			application := out.CreateApplication.Application
+			printOutput(config, application, func(w *tabwriter.Writer) {
+				printRow(w, "ID", "NAME")
+				printRow(w, application.ID, application.Name)
+			})
+		},
+	}
+	remove := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete an application.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := apiClient(config).GraphQL(context.Background(),
+				`mutation($id: ID!, $force: Boolean) { deleteApplication(id: $id, force: $force) }`,
+				map[string]interface{}{"id": args[0], "force": config.GetBool("force")}, nil)
+			if err != nil {
+				log.Fatalf("failed to delete application: %v", err)
+			}
+		},
+	}
+	remove.Flags().Bool("force", false, "Delete the application even if it still has profiles or records. Applications with connected sessions are never deleted.")
+	return apiCommand(config, "apps", "Manage applications.", list, create, remove)
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type cliDeviceCertificate struct {
+	SerialNumber         string     `json:"serialNumber"`
+	ApplicationProfileID string     `json:"applicationProfileId"`
+	Subject              string     `json:"subject"`
+	NotAfter             time.Time  `json:"notAfter"`
+	RevokedAt            *time.Time `json:"revokedAt"`
+}
+
+func printDeviceCertificates(config *viper.Viper, certs []cliDeviceCertificate) {
+	printOutput(config, certs, func(w *tabwriter.Writer) {
+		printRow(w, "SERIAL NUMBER", "PROFILE", "SUBJECT", "EXPIRES", "REVOKED")
+		for _, cert := range certs {
+			revoked := "-"
+			if cert.RevokedAt != nil {
+				revoked = cert.RevokedAt.Format(time.RFC3339)
+			}
+			printRow(w, cert.SerialNumber, cert.ApplicationProfileID, cert.Subject, cert.NotAfter.Format(time.RFC3339), revoked)
+		}
+	})
+}
+
+// Certificates manages device certificates. The wasp and vespiary brokers do not accept them yet, so devices
+// connecting to them still authenticate with their profile password.
+func Certificates(config *viper.Viper) *cobra.Command {
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List issued device certificates.",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, _ []string) {
+			out := struct {
+				DeviceCertificates []cliDeviceCertificate `json:"deviceCertificates"`
+			}{}
+			variables := map[string]interface{}{}
+			if applicationProfileID := config.GetString("application-profile-id"); applicationProfileID != "" {
+				variables["applicationProfileId"] = applicationProfileID
+			}
+			err := apiClient(config).GraphQL(context.Background(),
+				`query($applicationProfileId: ID) {
+					deviceCertificates(applicationProfileId: $applicationProfileId) { serialNumber applicationProfileId subject notAfter revokedAt }
+				}`, variables, &out)
+			if err != nil {
+				log.Fatalf("failed to list device certificates: %v", err)
+			}
+			printDeviceCertificates(config, out.DeviceCertificates)
+		},
+	}
+	list.Flags().String("application-profile-id", "", "Only list the certificates of this application profile.")
+	issue := &cobra.Command{
+		Use:   "issue <application-profile-id>",
+		Short: "Issue a device certificate, and write it to disk with its certificate authority.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			variables := map[string]interface{}{"applicationProfileId": args[0]}
+			if csrFile := config.GetString("csr-file"); csrFile != "" {
+				csr, err := ioutil.ReadFile(csrFile)
+				if err != nil {
+					log.Fatalf("failed to read certificate signing request: %v", err)
+				}
+				variables["csr"] = string(csr)
+			}
+			out := struct {
+				IssueDeviceCertificate struct {
+					DeviceCertificate struct {
+						cliDeviceCertificate
+						Certificate string `json:"certificate"`
+					} `json:"deviceCertificate"`
+					PrivateKey           *string `json:"privateKey"`
+					CertificateAuthority string  `json:"certificateAuthority"`
+				} `json:"issueDeviceCertificate"`
+			}{}
+			err := apiClient(config).GraphQL(context.Background(),
+				`mutation($applicationProfileId: ID!, $csr: String) {
+					issueDeviceCertificate(applicationProfileId: $applicationProfileId, csr: $csr) {
+						deviceCertificate { serialNumber applicationProfileId subject notAfter revokedAt certificate }
+						privateKey
+						certificateAuthority
+					}
+				}`, variables, &out)
+			if err != nil {
+				log.Fatalf("failed to issue device certificate: %v", err)
+			}
+			issued := out.IssueDeviceCertificate
+			files := []struct {
+				flag    string
+				content *string
+				mode    os.FileMode
+			}{
+				{flag: "certificate-file", content: &issued.DeviceCertificate.Certificate, mode: 0644},
+				{flag: "private-key-file", content: issued.PrivateKey, mode: 0600},
+				{flag: "ca-file", content: &issued.CertificateAuthority, mode: 0644},
+			}
+			for _, file := range files {
+				if file.content == nil {
+					continue
+				}
+				err := ioutil.WriteFile(config.GetString(file.flag), []byte(*file.content), file.mode)
+				if err != nil {
+					log.Fatalf("failed to write %s: %v", config.GetString(file.flag), err)
+				}
+			}
+			printDeviceCertificates(config, []cliDeviceCertificate{issued.DeviceCertificate.cliDeviceCertificate})
+		},
+	}
+	issue.Flags().String("csr-file", "", "Sign this PEM encoded certificate signing request. A private key is generated when omitted.")
+	issue.Flags().StringP("certificate-file", "c", "./device.crt", "Write the device certificate to this file.")
+	issue.Flags().StringP("private-key-file", "k", "./device.key", "Write the generated private key to this file.")
+	issue.Flags().String("ca-file", "./ca.crt", "Write the certificate authority to this file.")
+	revoke := &cobra.Command{
+		Use:   "revoke <serial-number>",
+		Short: "Revoke a device certificate.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			out := struct {
+				RevokeDeviceCertificate cliDeviceCertificate `json:"revokeDeviceCertificate"`
+			}{}
+			err := apiClient(config).GraphQL(context.Background(),
+				`mutation($serialNumber: ID!) {
+					revokeDeviceCertificate(serialNumber: $serialNumber) { serialNumber applicationProfileId subject notAfter revokedAt }
+				}`, map[string]interface{}{"serialNumber": args[0]}, &out)
+			if err != nil {
+				log.Fatalf("failed to revoke device certificate: %v", err)
+			}
+			printDeviceCertificates(config, []cliDeviceCertificate{out.RevokeDeviceCertificate})
+		},
+	}
+	return apiCommand(config, "certificates", "Manage device certificates.", list, issue, revoke)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/vx-labs/alveoli/alveoli/client"
+)
+
+// addAPIClientFlags adds the flags needed to reach a running alveoli server.
+func addAPIClientFlags(flags *pflag.FlagSet) {
+	flags.String("api-endpoint", "http://localhost:8080", "URL of the alveoli server.")
+	flags.String("api-token", "", "Bearer token used to authenticate on the alveoli server.")
+}
+
+func apiClient(config *viper.Viper) *client.Client {
+	return client.New(config.GetString("api-endpoint"), config.GetString("api-token"), nil)
+}
+
+// apiCommand returns a command grouping subcommands which call the alveoli API, and print their results as a
+// table or as JSON.
+func apiCommand(config *viper.Viper, use, short string, subcommands ...*cobra.Command) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use,
+		Short: short,
+		PersistentPreRun: func(c *cobra.Command, _ []string) {
+			config.BindPFlags(c.Flags())
+		},
+	}
+	addAPIClientFlags(c.PersistentFlags())
+	c.PersistentFlags().StringP("output", "o", "table", "Output format: table or json.")
+	c.AddCommand(subcommands...)
+	return c
+}
+
+// printOutput prints v as indented JSON when the output flag is json, or calls table to print it as a table.
+func printOutput(config *viper.Viper, v interface{}, table func(w *tabwriter.Writer)) {
+	switch config.GetString("output") {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(v)
+		if err != nil {
+			log.Fatalf("failed to encode output: %v", err)
+		}
+	case "table":
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		table(w)
+		w.Flush()
+	default:
+		log.Fatalf("unsupported output format %q", config.GetString("output"))
+	}
+}
+
+// printRow prints a tab separated table row.
+func printRow(w *tabwriter.Writer, columns ...interface{}) {
+	values := make([]string, len(columns))
+	for idx, column := range columns {
+		values[idx] = fmt.Sprint(column)
+	}
+	fmt.Fprintln(w, strings.Join(values, "\t"))
+}
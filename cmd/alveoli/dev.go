@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/vx-labs/alveoli/alveoli/auth"
+	"github.com/vx-labs/alveoli/alveoli/certificates"
+	"github.com/vx-labs/alveoli/alveoli/fakes"
+	"github.com/vx-labs/alveoli/alveoli/graph/resolvers"
+	"github.com/vx-labs/alveoli/alveoli/provisioning"
+	"github.com/vx-labs/alveoli/alveoli/server"
+	"github.com/vx-labs/alveoli/alveoli/store"
+	"github.com/vx-labs/alveoli/alveoli/tenancy"
+	"github.com/vx-labs/alveoli/alveoli/usage"
+	nest "github.com/vx-labs/nest/nest/api"
+	vespiary "github.com/vx-labs/vespiary/vespiary/api"
+	wasp "github.com/vx-labs/wasp/v4/wasp/api"
+)
+
+const (
+	devApplicationName = "demo"
+	devProfileName     = "device"
+	devProfilePassword = "demo-password"
+	// devDeviceCertificatesSecret derives the device certificate authority keys. Records are kept in memory, so
+	// the authorities never outlive the development server.
+	devDeviceCertificatesSecret = "dev"
+)
+
+// seedDevData creates a sample application and a day of sample records in the fake backends.
+func seedDevData(ctx context.Context, accountID string, v *fakes.Vespiary, w *fakes.Wasp, n *fakes.Nest) error {
+	application, err := v.CreateApplication(ctx, &vespiary.CreateApplicationRequest{
+		AccountID: accountID,
+		Name:      devApplicationName,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = v.CreateApplicationProfile(ctx, &vespiary.CreateApplicationProfileRequest{
+		AccountID:     accountID,
+		ApplicationID: application.ID,
+		Name:          devProfileName,
+		Password:      devProfilePassword,
+	})
+	if err != nil {
+		return err
+	}
+	sender := tenancy.SessionID(devApplicationName, devProfileName, "sample-sensor")
+	now := time.Now()
+	records := []*nest.Record{}
+	for idx := 0; idx < 24*12; idx++ {
+		timestamp := now.Add(-time.Duration(idx) * 5 * time.Minute)
+		records = append(records,
+			&nest.Record{
+				Timestamp: timestamp.UnixNano(),
+				Topic:     tenancy.Topic(accountID, application.ID, "sensors/living-room/temperature"),
+				Payload:   []byte(fmt.Sprintf(`{"celsius": %.1f, "battery": %d}`, 19+float64(idx%24)/4, 100-idx/4)),
+				Sender:    sender,
+			},
+			&nest.Record{
+				Timestamp: timestamp.UnixNano(),
+				Topic:     tenancy.Topic(accountID, application.ID, "sensors/garden/humidity"),
+				Payload:   []byte(fmt.Sprintf("%d", 40+idx%30)),
+				Sender:    sender,
+			},
+		)
+	}
+	_, err = n.PutRecords(ctx, &nest.PutRecordsRequest{Records: records})
+	if err != nil {
+		return err
+	}
+	w.Connect(&wasp.SessionMetadatas{
+		SessionID:  sender,
+		ClientID:   "sample-sensor",
+		MountPoint: tenancy.MountPoint(accountID, application.ID),
+	})
+	return nil
+}
+
+// DevServer runs alveoli on top of in-memory backends, seeded with sample data.
+func DevServer(config *viper.Viper) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "dev",
+		Short: "Run alveoli with in-memory backends and sample data, for local development.",
+		PreRun: func(c *cobra.Command, _ []string) {
+			config.BindPFlags(c.Flags())
+		},
+		Run: func(cmd *cobra.Command, _ []string) {
+			ctx := context.Background()
+			accountID := config.GetString("account-id")
+			accountName := config.GetString("account-name")
+
+			records := fakes.NewNest()
+			vespiaryClient := fakes.NewVespiary()
+			waspClient := fakes.NewWasp(records)
+			vespiaryClient.EnsureAccount(accountID, accountName)
+			err := seedDevData(ctx, accountID, vespiaryClient, waspClient, records)
+			if err != nil {
+				log.Fatalf("failed to seed sample data: %v", err)
+			}
+
+			mqttListener, err := net.Listen("tcp", fmt.Sprintf(":%d", config.GetInt("mqtt-port")))
+			if err != nil {
+				log.Fatalf("failed to listen mqtt: %v", err)
+			}
+			broker := fakes.NewBroker(waspClient, vespiaryClient)
+			go func() {
+				err := broker.Serve(ctx, mqttListener)
+				if err != nil {
+					log.Fatalf("mqtt broker failed: %v", err)
+				}
+			}()
+
+			authProvider := auth.Static(accountID, accountName)
+			authority := certificates.New(store.New(records), []byte(devDeviceCertificatesSecret))
+			resolverRoot := resolvers.Root(waspClient, vespiaryClient, records, usage.Limits{}, provisioning.Broker{
+				Host: "localhost",
+				Port: config.GetInt("mqtt-port"),
+			}, authority)
+			mux := server.Handler(authProvider, vespiaryClient, records, resolverRoot, authority)
+			listenAddr := fmt.Sprintf(":%d", config.GetInt("port"))
+			listener, err := net.Listen("tcp", listenAddr)
+			if err != nil {
+				log.Fatalf("failed to listen tcp: %v", err)
+			}
+			log.Printf("serving the API on http://localhost%s, using any bearer token", listenAddr)
+			log.Printf("serving MQTT on localhost:%d, with username %s/%s/%s and password %s",
+				config.GetInt("mqtt-port"), accountName, devApplicationName, devProfileName, devProfilePassword)
+			log.Fatal(http.Serve(listener, corsPolicy().Handler(&Logger{handler: mux})))
+		},
+	}
+	c.Flags().Int("port", 8080, "Run REST API on this port.")
+	c.Flags().Int("mqtt-port", 1883, "Run the MQTT broker on this port.")
+	c.Flags().String("account-id", "1", "The account-id of the development account.")
+	c.Flags().String("account-name", "dev", "The name of the development account, used in MQTT usernames.")
+	return c
+}
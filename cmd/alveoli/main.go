@@ -14,23 +14,20 @@ import (
 
 	"github.com/newrelic/go-agent/v3/newrelic"
 
-	"github.com/99designs/gqlgen/graphql/handler"
-	"github.com/99designs/gqlgen/graphql/handler/extension"
-	"github.com/99designs/gqlgen/graphql/handler/lru"
-	"github.com/99designs/gqlgen/graphql/handler/transport"
-	"github.com/99designs/gqlgen/graphql/playground"
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 	"github.com/google/uuid"
-	"github.com/gorilla/websocket"
-	"github.com/julienschmidt/httprouter"
 	"github.com/rs/cors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/vx-labs/alveoli/alveoli/auth"
-	"github.com/vx-labs/alveoli/alveoli/graph/generated"
+	"github.com/vx-labs/alveoli/alveoli/certificates"
 	"github.com/vx-labs/alveoli/alveoli/graph/resolvers"
-	"github.com/vx-labs/alveoli/alveoli/handlers"
+	"github.com/vx-labs/alveoli/alveoli/provisioning"
+	"github.com/vx-labs/alveoli/alveoli/retention"
 	"github.com/vx-labs/alveoli/alveoli/rpc"
+	"github.com/vx-labs/alveoli/alveoli/server"
+	"github.com/vx-labs/alveoli/alveoli/store"
+	"github.com/vx-labs/alveoli/alveoli/usage"
 	nest "github.com/vx-labs/nest/nest/api"
 	vespiary "github.com/vx-labs/vespiary/vespiary/api"
 	wasp "github.com/vx-labs/wasp/v4/wasp/api"
@@ -38,6 +35,28 @@ import (
 	"go.uber.org/zap"
 )
 
+// corsPolicy allows browsers to call the API from any origin.
+func corsPolicy() *cors.Cors {
+	return cors.New(cors.Options{
+		AllowedMethods: []string{
+			http.MethodGet,
+			http.MethodPatch,
+			http.MethodPost,
+			http.MethodDelete,
+		},
+		AllowedHeaders: []string{
+			"authorization",
+			"content-type",
+			"x-vx-product",
+			"last-event-id",
+		},
+		AllowCredentials: true,
+	})
+}
+
+// mqttBrokerTLSPort is the port of the MQTT broker listener, used by alveoli subscriptions and by devices.
+const mqttBrokerTLSPort = 8883
+
 func main() {
 	ctx := context.Background()
 	logConfig := zap.NewProductionConfig()
@@ -100,7 +119,7 @@ func main() {
 			var mqttClient mqtt.Client
 
 			if config.GetString("rpc-tls-private-key-file") != "" && config.GetString("rpc-tls-certificate-file") != "" {
-				mqttBrokerURL, err := url.Parse(fmt.Sprintf("tls://%s:8883", config.GetString("subscriptions-mqtt-broker")))
+				mqttBrokerURL, err := url.Parse(fmt.Sprintf("tls://%s:%d", config.GetString("subscriptions-mqtt-broker"), mqttBrokerTLSPort))
 				if err != nil {
 					panic("invalid broker url")
 				}
@@ -139,68 +158,35 @@ func main() {
 					}
 				}
 			}
-			srv := handler.New(
-				generated.NewExecutableSchema(
-					generated.Config{
-						Resolvers: resolvers.Root(
-							waspClient,
-							vespiaryClient,
-							nestClient,
-						),
-					},
-				),
-			)
-
-			srv.AddTransport(transport.Websocket{
-				KeepAlivePingInterval: 10 * time.Second,
-				InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
-					md, err := authProvider.Validate(ctx, initPayload.Authorization())
-					if err != nil {
-						log.Printf("websocket auth failed: %v", err)
-						return nil, err
-					}
-					log.Printf("websocket session started for account %s", md.AccountID)
-					return auth.StoreInformations(ctx, md), nil
-				},
-				Upgrader: websocket.Upgrader{
-					CheckOrigin: func(r *http.Request) bool {
-						return true
-					},
+			quotas, err := usage.LoadLimits(usage.Quotas{
+				MaxApplications:        config.GetInt("quota-max-applications"),
+				MaxApplicationProfiles: config.GetInt("quota-max-application-profiles"),
+				MaxStoredBytes:         config.GetInt("quota-max-stored-bytes"),
+			}, config.GetString("quota-overrides-file"))
+			if err != nil {
+				logger.Fatal("failed to load quota overrides", zap.Error(err))
+			}
+			authority := certificates.New(store.New(nestClient), []byte(config.GetString("device-certificates-secret")))
+			resolverRoot := resolvers.Root(
+				waspClient,
+				vespiaryClient,
+				nestClient,
+				quotas,
+				provisioning.Broker{
+					Host:       config.GetString("subscriptions-mqtt-broker"),
+					Port:       mqttBrokerTLSPort,
+					TLS:        true,
+					ServerName: config.GetString("subscriptions-mqtt-broker-sni"),
 				},
-			})
-			srv.AddTransport(transport.Options{})
-			srv.AddTransport(transport.GET{})
-			srv.AddTransport(transport.POST{})
-			srv.AddTransport(transport.MultipartForm{})
-
-			srv.SetQueryCache(lru.New(1000))
-
-			srv.Use(extension.Introspection{})
-			srv.Use(extension.AutomaticPersistedQuery{
-				Cache: lru.New(100),
-			})
+				authority,
+			)
+			if interval := config.GetDuration("retention-enforcement-interval"); interval > 0 {
+				enforcer := retention.NewEnforcer(store.New(nestClient), nestClient, logger)
+				go enforcer.Run(ctx, interval)
+			}
+			mux := server.Handler(authProvider, vespiaryClient, nestClient, resolverRoot, authority)
 
-			mux := http.NewServeMux()
-			router := httprouter.New()
-			handlers.Register(router, authProvider, vespiaryClient)
-			mux.Handle("/account/", router)
-			mux.Handle("/graphql", auth.Handler(authProvider, srv))
-			mux.Handle("/", playground.Handler("GraphQL playground", "/graphql"))
-
-			corsHandler := cors.New(cors.Options{
-				AllowedMethods: []string{
-					http.MethodGet,
-					http.MethodPatch,
-					http.MethodPost,
-					http.MethodDelete,
-				},
-				AllowedHeaders: []string{
-					"authorization",
-					"content-type",
-					"x-vx-product",
-				},
-				AllowCredentials: true,
-			})
+			corsHandler := corsPolicy()
 			listenAddr := fmt.Sprintf(":%d", config.GetInt("port"))
 
 			if config.GetBool("use-vault") {
@@ -239,12 +225,26 @@ func main() {
 	cmd.Flags().String("authentication-provider-static-account-id", "1", "The account-id to use when using static authentication provider.")
 	cmd.Flags().Bool("use-vault", false, "Use Hashicorp Vault to store private keys and certificates.")
 	cmd.Flags().String("tls-cn", "localhost", "Get ACME certificat for this Common Name.")
+	cmd.Flags().Int("quota-max-applications", 0, "Maximum number of applications per account. Set to 0 to disable.")
+	cmd.Flags().Int("quota-max-application-profiles", 0, "Maximum number of profiles per application. Set to 0 to disable.")
+	cmd.Flags().Int("quota-max-stored-bytes", 0, "Prevent accounts whose applications store more than this number of bytes from creating applications and profiles. Set to 0 to disable.")
+	cmd.Flags().String("quota-overrides-file", "", "JSON file mapping account IDs to the quotas replacing the default ones, such as {\"<account-id>\": {\"maxStoredBytes\": 0}}.")
+	cmd.Flags().String("device-certificates-secret", "", "Secret the device certificate authority keys of accounts are derived from. Device certificates cannot be issued when empty, and changing it invalidates the authorities of all accounts.")
+	cmd.Flags().Duration("retention-enforcement-interval", 0, "Check stored records against applications retention policies at this interval, and log the data exceeding them. Nothing is deleted: the record store cannot delete records. Disabled when 0.")
 
 	cmd.Flags().String("vespiary-grpc-address", "auth.iot.cloud.vx-labs.net:443", "auth service endpoint")
 	cmd.Flags().String("nest-grpc-address", "messages.iot.cloud.vx-labs.net:443", "auth service endpoint")
 	cmd.Flags().String("wasp-grpc-address", "rpc.iot.cloud.vx-labs.net:443", "auth service endpoint")
 
 	cmd.AddCommand(TLSHelper(config))
+	cmd.AddCommand(DevServer(config))
+	cmd.AddCommand(Apply(config))
+	cmd.AddCommand(Apps(config))
+	cmd.AddCommand(Profiles(config))
+	cmd.AddCommand(Sessions(config))
+	cmd.AddCommand(Topics(config))
+	cmd.AddCommand(Records(config))
+	cmd.AddCommand(Certificates(config))
 
 	cmd.Execute()
 }
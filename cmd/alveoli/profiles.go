@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type cliApplicationProfile struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	ApplicationID string `json:"applicationId"`
+	Enabled       bool   `json:"enabled"`
+}
+
+type cliDeviceConnection struct {
+	Host         string `json:"host"`
+	Port         int    `json:"port"`
+	TLS          bool   `json:"tls"`
+	ServerName   string `json:"serverName"`
+	Username     string `json:"username"`
+	ClientIDHint string `json:"clientIdHint"`
+	MountPoint   string `json:"mountPoint"`
+}
+
+type cliCreatedApplicationProfile struct {
+	ApplicationProfile struct {
+		cliApplicationProfile
+		Connection cliDeviceConnection `json:"connection"`
+	} `json:"applicationProfile"`
+	GeneratedPassword *string `json:"generatedPassword"`
+}
+
+func printApplicationProfiles(config *viper.Viper, profiles []cliApplicationProfile) {
+	printOutput(config, profiles, func(w *tabwriter.Writer) {
+		printRow(w, "ID", "NAME", "APPLICATION", "ENABLED")
+		for _, profile := range profiles {
+			printRow(w, profile.ID, profile.Name, profile.ApplicationID, profile.Enabled)
+		}
+	})
+}
+
+// Profiles manages the application profiles of an account.
+func Profiles(config *viper.Viper) *cobra.Command {
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List application profiles.",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, _ []string) {
+			out := struct {
+				ApplicationProfiles []cliApplicationProfile `json:"applicationProfiles"`
+			}{}
+			err := apiClient(config).GraphQL(context.Background(), `{ applicationProfiles { id name applicationId enabled } }`, nil, &out)
+			if err != nil {
+				log.Fatalf("failed to list application profiles: %v", err)
+			}
+			profiles := out.ApplicationProfiles
+			if applicationID := config.GetString("application-id"); applicationID != "" {
+				profiles = []cliApplicationProfile{}
+				for _, profile := range out.ApplicationProfiles {
+					if profile.ApplicationID == applicationID {
+						profiles = append(profiles, profile)
+					}
+				}
+			}
+			printApplicationProfiles(config, profiles)
+		},
+	}
+	list.Flags().String("application-id", "", "Only list the profiles of this application.")
+	create := &cobra.Command{
+		Use:   "create <application-id> <name>",
+		Short: "Create an application profile.",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			out := struct {
+				CreateApplicationProfile cliCreatedApplicationProfile `json:"createApplicationProfile"`
+			}{}
+			input := map[string]interface{}{
+				"applicationId": args[0],
+				"name":          args[1],
+			}
+			if password := config.GetString("password"); password != "" {
+				input["password"] = password
+			}
+			err := apiClient(config).GraphQL(context.Background(),
+				`mutation($input: CreateApplicationProfileInput!) {
+					createApplicationProfile(input: $input) {
+						applicationProfile { id name applicationId enabled
+							connection { host port tls serverName username clientIdHint mountPoint }
+						}
+						generatedPassword
+					}
+				}`,
+				map[string]interface{}{"input": input}, &out)
+			if err != nil {
+				log.Fatalf("failed to create application profile: %v", err)
+			}
+			created := out.CreateApplicationProfile
+			profile := created.ApplicationProfile
+			printOutput(config, created, func(w *tabwriter.Writer) {
+				printRow(w, "ID", profile.ID)
+				printRow(w, "NAME", profile.Name)
+				printRow(w, "APPLICATION", profile.ApplicationID)
+				printRow(w, "BROKER", fmt.Sprintf("%s:%d", profile.Connection.Host, profile.Connection.Port))
+				printRow(w, "TLS", profile.Connection.TLS)
+				if profile.Connection.TLS {
+					printRow(w, "SERVER NAME", profile.Connection.ServerName)
+				}
+				printRow(w, "USERNAME", profile.Connection.Username)
+				if created.GeneratedPassword != nil {
+					// The generated password is not stored in clear text, and cannot be displayed again.
+					printRow(w, "PASSWORD", *created.GeneratedPassword)
+				}
+				printRow(w, "CLIENT ID", profile.Connection.ClientIDHint)
+				printRow(w, "MOUNT POINT", profile.Connection.MountPoint)
+			})
+		},
+	}
+	create.Flags().String("password", "", "Password used by devices to authenticate with this profile. A strong password is generated when omitted.")
+	remove := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete an application profile.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := apiClient(config).GraphQL(context.Background(),
+				`mutation($id: ID!) { deleteApplicationProfile(id: $id) }`,
+				map[string]interface{}{"id": args[0]}, nil)
+			if err != nil {
+				log.Fatalf("failed to delete application profile: %v", err)
+			}
+		},
+	}
+	return apiCommand(config, "profiles", "Manage application profiles.", list, create, remove)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Records reads the records of an account.
+func Records(config *viper.Viper) *cobra.Command {
+	tail := &cobra.Command{
+		Use:   "tail <application-id>",
+		Short: "Print the records of an application as they are received.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			query := url.Values{}
+			if pattern := config.GetString("pattern"); pattern != "" {
+				query.Set("pattern", pattern)
+			}
+			if encoding := config.GetString("encoding"); encoding != "" {
+				query.Set("encoding", encoding)
+			}
+			path := fmt.Sprintf("/applications/%s/records/stream?%s", url.PathEscape(args[0]), query.Encode())
+			resp, err := apiClient(config).Do(context.Background(), http.MethodGet, path, nil)
+			if err != nil {
+				log.Fatalf("failed to stream records: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				body, _ := ioutil.ReadAll(resp.Body)
+				log.Fatalf("failed to stream records: unexpected status code %d: %s", resp.StatusCode, body)
+			}
+			output := config.GetString("output")
+			if output != "json" && output != "table" {
+				log.Fatalf("unsupported output format %q", output)
+			}
+			// The stream is made of server-sent events: only record and error events carry data.
+			event := ""
+			scanner := bufio.NewScanner(resp.Body)
+			scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+			for scanner.Scan() {
+				line := scanner.Text()
+				switch {
+				case strings.HasPrefix(line, "event: "):
+					event = strings.TrimPrefix(line, "event: ")
+				case strings.HasPrefix(line, "data: "):
+					data := strings.TrimPrefix(line, "data: ")
+					if event == "error" {
+						log.Fatalf("record stream failed: %s", data)
+					}
+					if event != "record" {
+						continue
+					}
+					if output == "json" {
+						fmt.Println(data)
+						continue
+					}
+					record := struct {
+						TopicName string    `json:"topicName"`
+						Payload   string    `json:"payload"`
+						SentBy    string    `json:"sentBy"`
+						SentAt    time.Time `json:"sentAt"`
+					}{}
+					err := json.Unmarshal([]byte(data), &record)
+					if err != nil {
+						log.Fatalf("failed to decode record: %v", err)
+					}
+					fmt.Printf("%s  %s  %s\n", record.SentAt.Local().Format(time.RFC3339), record.TopicName, record.Payload)
+				case line == "":
+					event = ""
+				}
+			}
+			if err := scanner.Err(); err != nil {
+				log.Fatalf("record stream failed: %v", err)
+			}
+		},
+	}
+	tail.Flags().String("pattern", "", "Only print records sent on topics matching this MQTT topic filter.")
+	tail.Flags().String("encoding", "", "Payload encoding: UTF8, BASE64 or HEX. Defaults to UTF8 for valid UTF-8 payloads and BASE64 otherwise.")
+	return apiCommand(config, "records", "Read records.", tail)
+}
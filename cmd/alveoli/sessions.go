@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"log"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Sessions lists the MQTT sessions of an account.
+//
+// Sessions cannot be disconnected: the broker does not expose any API to kick a session, and the kick command only
+// explains it. Deleting the application profile of a session prevents it from connecting again.
+func Sessions(config *viper.Viper) *cobra.Command {
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List connected MQTT sessions.",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, _ []string) {
+			out := struct {
+				Sessions []struct {
+					ID                   string    `json:"id"`
+					ClientID             string    `json:"clientId"`
+					ApplicationID        string    `json:"applicationId"`
+					ApplicationProfileID string    `json:"applicationProfileId"`
+					ConnectedAt          time.Time `json:"connectedAt"`
+				} `json:"sessions"`
+			}{}
+			err := apiClient(config).GraphQL(context.Background(),
+				`{ sessions { id clientId applicationId applicationProfileId connectedAt } }`, nil, &out)
+			if err != nil {
+				log.Fatalf("failed to list sessions: %v", err)
+			}
+			printOutput(config, out.Sessions, func(w *tabwriter.Writer) {
+				printRow(w, "ID", "CLIENT ID", "APPLICATION", "PROFILE", "CONNECTED AT")
+				for _, session := range out.Sessions {
+					printRow(w, session.ID, session.ClientID, session.ApplicationID, session.ApplicationProfileID, session.ConnectedAt.Local().Format(time.RFC3339))
+				}
+			})
+		},
+	}
+	kick := &cobra.Command{
+		Use:   "kick <id>",
+		Short: "Disconnect an MQTT session (not supported by the broker).",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			log.Fatalf("failed to kick session %s: the broker does not support disconnecting sessions; "+
+				"delete its application profile to prevent it from connecting again", args[0])
+		},
+	}
+	return apiCommand(config, "sessions", "Inspect MQTT sessions.", list, kick)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"log"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Topics lists the topics of an account.
+func Topics(config *viper.Viper) *cobra.Command {
+	list := &cobra.Command{
+		Use:     "ls",
+		Aliases: []string{"list"},
+		Short:   "List topics holding records.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, _ []string) {
+			out := struct {
+				Topics []struct {
+					Name               string `json:"name"`
+					ApplicationID      string `json:"applicationId"`
+					GuessedContentType string `json:"guessedContentType"`
+					MessageCount       int    `json:"messageCount"`
+					SizeInBytes        int    `json:"sizeInBytes"`
+				} `json:"topics"`
+			}{}
+			var pattern interface{}
+			if value := config.GetString("pattern"); value != "" {
+				pattern = value
+			}
+			err := apiClient(config).GraphQL(context.Background(),
+				`query($pattern: String) { topics(pattern: $pattern) { name applicationId guessedContentType messageCount sizeInBytes } }`,
+				map[string]interface{}{"pattern": pattern}, &out)
+			if err != nil {
+				log.Fatalf("failed to list topics: %v", err)
+			}
+			printOutput(config, out.Topics, func(w *tabwriter.Writer) {
+				printRow(w, "NAME", "APPLICATION", "CONTENT TYPE", "RECORDS", "SIZE")
+				for _, topic := range out.Topics {
+					printRow(w, topic.Name, topic.ApplicationID, topic.GuessedContentType, topic.MessageCount, topic.SizeInBytes)
+				}
+			})
+		},
+	}
+	list.Flags().String("pattern", "", "Only list topics matching this MQTT topic filter.")
+	return apiCommand(config, "topics", "Inspect topics.", list)
+}
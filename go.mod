@@ -1,12 +1,13 @@
 module github.com/vx-labs/alveoli
 
-go 1.14
+go 1.15
 
 require (
 	github.com/99designs/gqlgen v0.13.0
 	github.com/auth0/go-jwt-middleware v0.0.0-20200507191422-d30d7b9ece63
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/eclipse/paho.mqtt.golang v1.2.0
+	github.com/fxamacker/cbor/v2 v2.2.0
 	github.com/google/uuid v1.1.2
 	github.com/gorilla/websocket v1.4.2
 	github.com/grpc-ecosystem/go-grpc-middleware v1.2.0
@@ -15,13 +16,20 @@ require (
 	github.com/newrelic/go-agent/v3 v3.10.0
 	github.com/rs/cors v1.7.0
 	github.com/spf13/cobra v1.0.0
+	github.com/spf13/pflag v1.0.3
 	github.com/spf13/viper v1.7.0
 	github.com/vektah/gqlparser/v2 v2.1.0
+	github.com/vmihailenco/msgpack/v5 v5.0.0
+	github.com/vx-labs/mqtt-protocol v5.1.1+incompatible
 	github.com/vx-labs/nest v1.2.2
 	github.com/vx-labs/vespiary v1.2.5
 	github.com/vx-labs/wasp v1.7.6
 	github.com/vx-labs/wasp/v4 v4.0.1
+	github.com/xeipuuv/gojsonschema v1.2.0
+	github.com/xitongsys/parquet-go v1.5.4
 	go.uber.org/zap v1.16.0
 	golang.org/x/net v0.0.0-20201110031124-69a78807bb2b // indirect
 	google.golang.org/grpc v1.33.2
+	google.golang.org/protobuf v1.25.0
+	gopkg.in/yaml.v2 v2.3.0
 )